@@ -0,0 +1,172 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"encoding/json"
+	"github.com/golangee/log/level"
+	"github.com/golangee/log/pipeline"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// A StatsProvider reports the health of sinks, like *pipeline.Pipeline.
+type StatsProvider interface {
+	Stats() []pipeline.SinkStats
+}
+
+// Options configure the Handler.
+type Options struct {
+	// Levels is the store to inspect and modify. If nil, level.Default is used.
+	Levels *level.Store
+	// Sinks is optional and reports the sink health.
+	Sinks StatsProvider
+}
+
+// Logger describes the effective threshold of a known logger name.
+type Logger struct {
+	Name  string      `json:"name"`
+	Level level.Level `json:"level"`
+}
+
+// Loggers is the response of GET /loggers.
+type Loggers struct {
+	Root    level.Level  `json:"root"`
+	Loggers []Logger     `json:"loggers"`
+	Rules   []level.Rule `json:"rules"`
+}
+
+// Handler returns a http.Handler with the following endpoints, relative to where it is mounted (use
+// http.StripPrefix):
+//   - GET /loggers lists the known logger names with their effective thresholds and all rules
+//   - PUT /loggers/{prefix}?level=debug&ttl=10m sets the threshold for a prefix, optionally with an expiry
+//   - DELETE /loggers/{prefix} resets the threshold of a prefix, so that it is inherited again
+//   - PUT /root?level=info sets the root threshold
+//   - GET /sinks reports the sink health and dropped-event counters
+func Handler(opts Options) http.Handler {
+	store := opts.Levels
+	if store == nil {
+		store = level.Default
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/loggers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		writeJSON(w, loggers(store))
+	})
+
+	mux.HandleFunc("/loggers/", func(w http.ResponseWriter, r *http.Request) {
+		prefix := strings.TrimPrefix(r.URL.Path, "/loggers/")
+		if prefix == "" {
+			http.Error(w, "prefix required, use /root for the root threshold", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodPut, http.MethodPost:
+			if err := level.ValidatePattern(prefix); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			l, ttl, err := parseLevel(r)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			store.Set(prefix, l, ttl)
+		case http.MethodDelete:
+			store.Reset(prefix)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		writeJSON(w, loggers(store))
+	})
+
+	mux.HandleFunc("/root", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut && r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		l, _, err := parseLevel(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		store.SetRoot(l)
+		writeJSON(w, loggers(store))
+	})
+
+	mux.HandleFunc("/sinks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		stats := []pipeline.SinkStats{}
+		if opts.Sinks != nil {
+			stats = opts.Sinks.Stats()
+		}
+
+		writeJSON(w, stats)
+	})
+
+	return mux
+}
+
+func loggers(store *level.Store) Loggers {
+	res := Loggers{Root: store.Root(), Loggers: []Logger{}, Rules: store.Rules()}
+	for _, name := range store.Known() {
+		res.Loggers = append(res.Loggers, Logger{Name: name, Level: store.Effective(name)})
+	}
+
+	return res
+}
+
+// parseLevel reads the level and the optional ttl query parameters.
+func parseLevel(r *http.Request) (level.Level, time.Duration, error) {
+	l, err := level.Parse(r.URL.Query().Get("level"))
+	if err != nil {
+		return l, 0, err
+	}
+
+	var ttl time.Duration
+	if v := r.URL.Query().Get("ttl"); v != "" {
+		ttl, err = time.ParseDuration(v)
+		if err != nil {
+			return l, 0, err
+		}
+	}
+
+	return l, ttl, nil
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+}
@@ -0,0 +1,110 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"encoding/json"
+	"github.com/golangee/log/ecs"
+	"github.com/golangee/log/level"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandler(t *testing.T) {
+	store := level.NewStore(level.Info)
+	var printed int
+	logger := store.Filter(func(fields ...interface{}) {
+		printed++
+	})
+
+	srv := httptest.NewServer(http.StripPrefix("/admin", Handler(Options{Levels: store})))
+	defer srv.Close()
+
+	logger(ecs.Debug(), ecs.Log("my.service.db"))
+	if printed != 0 {
+		t.Fatal("expected debug to be filtered")
+	}
+
+	do(t, http.MethodPut, srv.URL+"/admin/loggers/my.service?level=debug&ttl=1h")
+	logger(ecs.Debug(), ecs.Log("my.service.db"))
+	logger(ecs.Debug(), ecs.Log("my.services"))
+
+	if printed != 1 {
+		t.Fatalf("expected only the child to be raised but printed %d", printed)
+	}
+
+	var res Loggers
+	if err := json.Unmarshal(do(t, http.MethodGet, srv.URL+"/admin/loggers"), &res); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Loggers) != 2 || res.Loggers[0].Level != level.Debug || res.Loggers[1].Level != level.Info {
+		t.Fatalf("unexpected loggers %+v", res)
+	}
+
+	if len(res.Rules) != 1 || time.Until(res.Rules[0].Expires) <= 0 {
+		t.Fatalf("expected expiring rule but got %+v", res.Rules)
+	}
+
+	do(t, http.MethodDelete, srv.URL+"/admin/loggers/my.service")
+	if store.Effective("my.service.db") != level.Info {
+		t.Fatal("expected reset")
+	}
+
+	req, err := http.NewRequest(http.MethodPut, srv.URL+"/admin/loggers/my.serv*?level=debug", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invalid, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = invalid.Body.Close()
+
+	if invalid.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected bad request for an invalid pattern but got %d", invalid.StatusCode)
+	}
+}
+
+func do(t *testing.T, method, url string) []byte {
+	t.Helper()
+
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected status %d", res.StatusCode)
+	}
+
+	var tmp json.RawMessage
+	if err := json.NewDecoder(res.Body).Decode(&tmp); err != nil {
+		t.Fatal(err)
+	}
+
+	return tmp
+}
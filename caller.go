@@ -0,0 +1,94 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"github.com/golangee/log/ecs"
+	"github.com/golangee/log/stack"
+	"path"
+	"runtime"
+	"sync"
+)
+
+// origin is a resolved and cached program counter.
+type origin struct {
+	internal bool
+	fields   []interface{}
+}
+
+//nolint:gochecknoglobals
+var origins sync.Map // uintptr => *origin
+
+// WithCaller returns a new logger function which always prepends the location of the caller as
+// "log.origin.file.name", "log.origin.file.line" and "log.origin.function". The caller is the first frame which
+// does not belong to the go runtime or to this module, see stack.Internal, so it does not matter how many
+// LoggerFunc, WithFields, NewLogger or WithFunc indirections are in between. Resolved program counters are
+// cached, so only the cost of runtime.Callers remains.
+func WithCaller(next func(fields ...interface{})) func(fields ...interface{}) {
+	return func(fields ...interface{}) {
+		if o := caller(); o != nil {
+			tmp := make([]interface{}, 0, len(o.fields)+len(fields))
+			tmp = append(tmp, o.fields...)
+			fields = append(tmp, fields...)
+		}
+
+		next(fields...)
+	}
+}
+
+// caller returns the first frame outside of the logging infrastructure or nil.
+func caller() *origin {
+	var pcs [32]uintptr
+	n := runtime.Callers(3, pcs[:])
+	for _, pc := range pcs[:n] {
+		if o := resolve(pc); !o.internal {
+			return o
+		}
+	}
+
+	return nil
+}
+
+// resolve looks up the cached origin for the program counter.
+func resolve(pc uintptr) *origin {
+	if o, ok := origins.Load(pc); ok {
+		return o.(*origin)
+	}
+
+	// a single pc expands to multiple frames, if calls have been inlined
+	o := &origin{internal: true}
+	frames := runtime.CallersFrames([]uintptr{pc})
+	for {
+		frame, more := frames.Next()
+		if !stack.Internal(stack.Frame{Function: frame.Function}) {
+			o.internal = false
+			o.fields = []interface{}{
+				ecs.LogOriginFileName(path.Base(frame.File)),
+				ecs.LogOriginFileLine(frame.Line),
+				ecs.LogOriginFunction(frame.Function),
+			}
+
+			break
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	origins.Store(pc, o)
+
+	return o
+}
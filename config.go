@@ -0,0 +1,339 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/golangee/log/ecs"
+	"github.com/golangee/log/ecs/schema"
+	"github.com/golangee/log/level"
+	"github.com/golangee/log/simple"
+	"io"
+	log2 "log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The environment variables which are evaluated by ConfigFromEnv.
+const (
+	EnvFormat     = "LOG_FORMAT"
+	EnvLevel      = "LOG_LEVEL"
+	EnvOutput     = "LOG_OUTPUT"
+	EnvColor      = "LOG_COLOR"
+	EnvTimeFormat = "LOG_TIME_FORMAT"
+	EnvValidate   = "LOG_VALIDATE_ECS"
+)
+
+// The supported formats, see also the according simple loggers.
+const (
+	FormatJSON      = "json"       // simple.PrintStructured
+	FormatECSNested = "ecs-nested" // simple.PrintNested
+	FormatLogfmt    = "logfmt"     // simple.PrintLogfmt
+	FormatConsole   = "console"    // simple.PrintColored or simple.PrintConsole
+	FormatText      = "text"       // simple.Print
+)
+
+// The special outputs and time formats. Any other output is a file path and any other time format a layout
+// for time.Format.
+const (
+	OutputStdout = "stdout"
+	OutputStderr = "stderr"
+
+	TimeRFC3339     = "rfc3339"
+	TimeRFC3339Nano = "rfc3339nano"
+	TimeNone        = "none"
+)
+
+// Config describes the default logger. Use Apply to make it the default.
+type Config struct {
+	// Format is one of the Format* constants.
+	Format string
+	// Level is the minimum level of events to print.
+	Level level.Level
+	// Rules override the Level for logger names, see level.ParseRules.
+	Rules []level.Rule
+	// Output is stdout, stderr or a file path to append to.
+	Output string
+	// Color enables colors for the console format.
+	Color bool
+	// TimeFormat is one of the Time* constants or a layout for time.Format.
+	TimeFormat string
+	// Verbosity is the global level for Verbose, see SetVerbosity.
+	Verbosity int
+	// VModule contains the verbosity rules per file or package, see SetVModule.
+	VModule string
+	// ValidateECS checks each event against the ECS field definitions and emits a warning for unknown or
+	// mistyped fields, see schema.Validate.
+	ValidateECS bool
+}
+
+//nolint:gochecknoglobals
+var (
+	outputMutex sync.Mutex
+	outputFile  io.Closer
+)
+
+// DefaultConfig returns the configuration derived by the IsDevelopment heuristic, which is the colored console
+// format with ECS validation for a developer and json otherwise.
+func DefaultConfig() Config {
+	cfg := Config{
+		Format:     FormatJSON,
+		Level:      level.Trace,
+		Output:     OutputStderr,
+		TimeFormat: TimeRFC3339,
+	}
+
+	if IsDevelopment() {
+		cfg.Format = FormatConsole
+		cfg.Color = true
+		cfg.ValidateECS = true
+	}
+
+	return cfg
+}
+
+// ConfigFromEnv starts with the DefaultConfig and overrides each value whose environment variable is set, so
+// that the explicit variables always take precedence over the heuristics:
+//   - LOG_FORMAT is one of json, ecs-nested, logfmt, console or text
+//   - LOG_LEVEL is the minimum level, like info, optionally followed by rules, like info,my.*=debug,*.http=warn
+//   - LOG_OUTPUT is stdout, stderr or a file path
+//   - LOG_COLOR is a boolean and enables colors for the console format
+//   - LOG_TIME_FORMAT is rfc3339, rfc3339nano, none or a custom layout like 2006-01-02 15:04:05
+//   - LOG_VALIDATE_ECS is a boolean and enables the validation of fields against the ECS definitions
+//
+// Invalid values are ignored and reported by the returned error.
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig()
+
+	var errs []string
+
+	if v, ok := lookupEnv(EnvFormat); ok {
+		if err := validateFormat(v); err != nil {
+			errs = append(errs, EnvFormat+": "+err.Error())
+		} else {
+			cfg.Format = v
+			cfg.Color = v == FormatConsole
+		}
+	}
+
+	if v, ok := lookupEnv(EnvLevel); ok {
+		if err := cfg.setLevels(v); err != nil {
+			errs = append(errs, EnvLevel+": "+err.Error())
+		}
+	}
+
+	if v, ok := lookupEnv(EnvOutput); ok {
+		cfg.Output = v
+	}
+
+	if v, ok := lookupEnv(EnvColor); ok {
+		if b, err := strconv.ParseBool(v); err != nil {
+			errs = append(errs, EnvColor+": "+err.Error())
+		} else {
+			cfg.Color = b
+		}
+	}
+
+	if v, ok := lookupEnv(EnvTimeFormat); ok {
+		cfg.TimeFormat = v
+	}
+
+	if v, ok := lookupEnv(EnvValidate); ok {
+		if b, err := strconv.ParseBool(v); err != nil {
+			errs = append(errs, EnvValidate+": "+err.Error())
+		} else {
+			cfg.ValidateECS = b
+		}
+	}
+
+	if len(errs) > 0 {
+		return cfg, errors.New("invalid logging configuration: " + strings.Join(errs, "; "))
+	}
+
+	return cfg, nil
+}
+
+// RegisterFlags binds the configuration to the according -log-* flags of the given set. The current values
+// are used as defaults, so flags take precedence over environment variables, if c has been created with
+// ConfigFromEnv. Call Apply after parsing the flags.
+func (c *Config) RegisterFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.Format, "log-format", c.Format, "log format: json, ecs-nested, logfmt, console or text")
+	fs.Func("log-level", "minimum log level and optional rules, like info,my.*=debug", c.setLevels)
+	fs.StringVar(&c.Output, "log-output", c.Output, "log output: stdout, stderr or a file path")
+	fs.BoolVar(&c.Color, "log-color", c.Color, "enable colors for the console log format")
+	fs.StringVar(&c.TimeFormat, "log-time-format", c.TimeFormat, "log time format: rfc3339, rfc3339nano, none or a "+
+		"custom layout")
+	fs.BoolVar(&c.ValidateECS, "log-validate-ecs", c.ValidateECS, "warn about fields which are not defined by ECS")
+	fs.IntVar(&c.Verbosity, "v", c.Verbosity, "verbosity level for log.Verbose")
+	fs.StringVar(&c.VModule, "vmodule", c.VModule, "comma separated list of pattern=N verbosity rules per file or "+
+		"package")
+}
+
+// Validate checks the format and the level.
+func (c Config) Validate() error {
+	if err := validateFormat(c.Format); err != nil {
+		return err
+	}
+
+	if c.Level < level.Trace || c.Level > level.Panic {
+		return fmt.Errorf("invalid level %v", c.Level)
+	}
+
+	return nil
+}
+
+// Apply validates the configuration, opens the output and sets the default logger, see also SetDefault.
+// A previously opened output file is closed and the Rules replace all rules of level.Default.
+func (c Config) Apply() error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	if err := SetVModule(c.VModule); err != nil {
+		return err
+	}
+
+	if err := c.applyOutput(); err != nil {
+		return err
+	}
+
+	SetVerbosity(c.Verbosity)
+
+	level.Default.SetRoot(c.Level)
+	level.Default.ReplaceRules(c.Rules...)
+	SetDefault(c.Logger())
+
+	return nil
+}
+
+// Logger returns the logger function described by the configuration, without applying the output and the level.
+// The returned function consults level.Default, whose root threshold is set to Level by Apply. Warnings about
+// invalid ECS fields bypass the level.
+func (c Config) Logger() func(fields ...interface{}) {
+	var f func(fields ...interface{})
+
+	switch c.Format {
+	case FormatECSNested:
+		f = simple.PrintNested
+	case FormatLogfmt:
+		f = simple.PrintLogfmt
+	case FormatConsole:
+		if c.Color {
+			f = simple.PrintColored
+		} else {
+			f = simple.PrintConsole
+		}
+	case FormatText:
+		f = simple.Print
+	default:
+		f = simple.PrintStructured
+	}
+
+	switch strings.ToLower(c.TimeFormat) {
+	case TimeNone:
+	case TimeRFC3339, "":
+		f = ecs.WithTime(f)
+	case TimeRFC3339Nano:
+		f = ecs.WithTimeFormat(f, time.RFC3339Nano)
+	default:
+		f = ecs.WithTimeFormat(f, c.TimeFormat)
+	}
+
+	if c.ValidateECS {
+		f = schema.Validate(f, schema.Options{})
+	}
+
+	return level.Default.Filter(f)
+}
+
+// applyOutput redirects the standard library logger, which is used by the simple loggers.
+func (c Config) applyOutput() error {
+	var (
+		w    io.Writer
+		file *os.File
+	)
+
+	switch c.Output {
+	case OutputStdout:
+		w = os.Stdout
+	case OutputStderr, "":
+		w = os.Stderr
+	default:
+		f, err := os.OpenFile(c.Output, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644) //nolint:gosec
+		if err != nil {
+			return fmt.Errorf("cannot open log output: %w", err)
+		}
+
+		w = f
+		file = f
+	}
+
+	outputMutex.Lock()
+	defer outputMutex.Unlock()
+
+	log2.SetOutput(w)
+
+	if outputFile != nil {
+		_ = outputFile.Close()
+		outputFile = nil
+	}
+
+	if file != nil {
+		outputFile = file
+	}
+
+	return nil
+}
+
+func validateFormat(format string) error {
+	switch format {
+	case FormatJSON, FormatECSNested, FormatLogfmt, FormatConsole, FormatText:
+		return nil
+	default:
+		return fmt.Errorf("unknown log format '%s', expected one of json, ecs-nested, logfmt, console or text",
+			format)
+	}
+}
+
+// setLevels parses the rules and takes the root rule as Level.
+func (c *Config) setLevels(s string) error {
+	rules, err := level.ParseRules(s)
+	if err != nil {
+		return err
+	}
+
+	c.Rules = nil
+	for _, r := range rules {
+		if r.Prefix == "" {
+			c.Level = r.Level
+		} else {
+			c.Rules = append(c.Rules, r)
+		}
+	}
+
+	return nil
+}
+
+// lookupEnv returns the trimmed value, if it is not empty.
+func lookupEnv(key string) (string, bool) {
+	v := strings.TrimSpace(os.Getenv(key))
+
+	return v, v != ""
+}
@@ -19,7 +19,10 @@
 // structured logging API.
 //
 // The default logger is created at package initialization time and
-// if your application is executed from the IDE it uses the simple.PrintColored and otherwise
-// simple.PrintStructured logger. Note, that the simple loggers use the standard library log.Print
+// if your application is executed from the IDE it uses the simple.PrintColored logger and validates the fields
+// against ECS, and otherwise the simple.PrintStructured logger. The environment variables LOG_FORMAT, LOG_LEVEL,
+// LOG_OUTPUT, LOG_COLOR, LOG_TIME_FORMAT and LOG_VALIDATE_ECS take precedence over this heuristic,
+// see ConfigFromEnv.
+// Note, that the simple loggers use the standard library log.Print
 // function and disables their time printing (log.SetFlags(0)).
 package log
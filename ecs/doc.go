@@ -14,4 +14,9 @@
 
 // Package ecs provides helpers and common field functions for the Elastic Common Schema, see also
 // https://www.elastic.co/guide/en/ecs/current/ecs-field-reference.html
+//
+// The files with the suffix _generated.go contain a constructor for each field which has no hand written one, key
+// constants and the allowed values. They are generated from the vendored ECS definitions in the gen module.
 package ecs
+
+//go:generate go run -C gen . -version 8.17.0
@@ -15,6 +15,7 @@
 package ecs
 
 import (
+	"github.com/golangee/log/field"
 	"time"
 )
 
@@ -36,7 +37,15 @@ func Time() Field {
 	}
 }
 
-// Tags is a list of keywords used to tag each event. The key is "tags".
+// TimeFormat works like Time, but the timestamp is formatted using the given layout, see also time.Format.
+func TimeFormat(layout string) Field {
+	return Field{
+		K: "@timestamp",
+		V: time.Now().Format(layout),
+	}
+}
+
+// Tags is a list of keywords used to tag each event. The key is "tags". The tags of multiple fields are united.
 func Tags(tags ...string) Field {
 	return Field{
 		K: "tags",
@@ -44,10 +53,25 @@ func Tags(tags ...string) Field {
 	}
 }
 
-// Labels are un-nestable custom key/value pairs. The key is "labels".
+// Labels are un-nestable custom key/value pairs. The key is "labels". Dots are not allowed in label keys and
+// are replaced by underscores, see field.LabelKey. Labels of multiple fields are merged key by key.
 func Labels(labels map[string]string) Field {
+	tmp := make(map[string]string, len(labels))
+	for k, v := range labels {
+		tmp[field.LabelKey(k)] = v
+	}
+
 	return Field{
-		K: "tags",
-		V: labels,
+		K: "labels",
+		V: tmp,
+	}
+}
+
+// Label is a single custom key/value pair in its flattened form. The key is "labels.<key>" with dots in key
+// replaced by underscores.
+func Label(key, value string) Field {
+	return Field{
+		K: field.LabelsPrefix + field.LabelKey(key),
+		V: value,
 	}
 }
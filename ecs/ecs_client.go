@@ -0,0 +1,32 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+// ClientIP is the ip address of the client, either IPv4 or IPv6. The key is "client.ip".
+func ClientIP(ip string) Field {
+	return Field{
+		K: "client.ip",
+		V: ip,
+	}
+}
+
+// ClientAddress is the raw address of the client, like a domain name, an ip or a unix socket. The key is
+// "client.address".
+func ClientAddress(adr string) Field {
+	return Field{
+		K: "client.address",
+		V: adr,
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+// DestinationDomain is the domain name of the destination system. The key is "destination.domain".
+func DestinationDomain(domain string) Field {
+	return Field{
+		K: "destination.domain",
+		V: domain,
+	}
+}
+
+// DestinationIP is the ip address of the destination, either IPv4 or IPv6. The key is "destination.ip".
+func DestinationIP(ip string) Field {
+	return Field{
+		K: "destination.ip",
+		V: ip,
+	}
+}
+
+// DestinationPort is the port of the destination. The key is "destination.port".
+func DestinationPort(port int) Field {
+	return Field{
+		K: "destination.port",
+		V: port,
+	}
+}
+
+// DestinationAddress is the raw address of the destination, like a domain name, an ip or a unix socket. The key
+// is "destination.address".
+func DestinationAddress(adr string) Field {
+	return Field{
+		K: "destination.address",
+		V: adr,
+	}
+}
@@ -15,19 +15,32 @@
 package ecs
 
 import (
+	"github.com/golangee/log/field"
+	"github.com/golangee/log/stack"
 	"reflect"
-	"runtime/debug"
-	"strings"
 )
 
 // ErrStack is a factory to capture the current stack trace. This is quite expensive. The key is "error.stack_trace".
+// Frames of the go runtime and of this module are omitted, so the trace starts at the caller.
 func ErrStack() Field {
-	fullTrace := string(debug.Stack())
-	lines := strings.Split(fullTrace, "\n")
-	shortTrace := strings.Join(lines[6:], "\n")
+	return ErrStackTrace(stack.Capture(1).Filter(stack.Internal))
+}
+
+// ErrStackTrace renders the given trace in its compact form, with one frame per line. The key is
+// "error.stack_trace".
+func ErrStackTrace(trace stack.Trace) Field {
 	return Field{
 		K: "error.stack_trace",
-		V: shortTrace,
+		V: trace.String(),
+	}
+}
+
+// ErrStackFrames captures the current stack trace like ErrStack, but keeps the structured form of function, file
+// and line for each frame. This is not defined by ECS. The key is "error.stack_frames".
+func ErrStackFrames() Field {
+	return Field{
+		K: "error.stack_frames",
+		V: stack.Capture(1).Filter(stack.Internal),
 	}
 }
 
@@ -44,15 +57,15 @@ func ErrMsg(err error) Field {
 	return f
 }
 
-// ErrType creates a field to describe the go-name of the error type. The key is "error.type". When using
-// fmt.Errorf this is mostly useless.
+// ErrType creates a field to describe the go-name of the error type. The key is "error.type". The type
+// is taken from the root cause, because for errors wrapped by fmt.Errorf the type is mostly useless.
 func ErrType(err error) Field {
 	f := Field{
 		K: "error.type",
 	}
 
 	if err != nil {
-		f.V = reflect.TypeOf(err).String()
+		f.V = reflect.TypeOf(field.RootCause(err)).String()
 	}
 
 	return f
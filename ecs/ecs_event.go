@@ -0,0 +1,77 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import "time"
+
+// EventDuration is the duration of the event in nanoseconds. The key is "event.duration".
+func EventDuration(d time.Duration) Field {
+	return Field{
+		K: "event.duration",
+		V: d.Nanoseconds(),
+	}
+}
+
+// EventOutcome denotes whether the event represents a success or a failure from the perspective of the entity
+// that produced the event, see EventOutcomeSuccess, EventOutcomeFailure and EventOutcomeUnknown. The key is
+// "event.outcome".
+func EventOutcome(outcome EventOutcomeValue) Field {
+	return Field{
+		K: "event.outcome",
+		V: string(outcome),
+	}
+}
+
+// Event categorizes the event for SIEM use by event.kind, event.category and event.type. Values which are not
+// allowed by ECS, e.g. created by a type conversion, are omitted, so that they cannot break the categorization.
+// Use EventCategoryValue.ExpectedEventTypes to find the types which fit to the category. The other event fields,
+// like EventAction, EventCode, EventStart, EventEnd, EventSequence or EventDataset, are generated.
+func Event(kind EventKindValue, category EventCategoryValue, types ...EventTypeValue) []Field {
+	var res []Field
+	if kind.Valid() {
+		res = append(res, EventKind(kind))
+	}
+
+	if category.Valid() {
+		res = append(res, EventCategory(category))
+	}
+
+	valid := make([]EventTypeValue, 0, len(types))
+	for _, t := range types {
+		if t.Valid() {
+			valid = append(valid, t)
+		}
+	}
+
+	if len(valid) > 0 {
+		res = append(res, EventType(valid...))
+	}
+
+	return res
+}
+
+// EventDone stamps event.start, event.end, event.duration and event.outcome, which is failure if err is not nil
+// and success otherwise. The error itself is not included. A typical use is
+//
+//	defer func(start time.Time) { logger.Println(ecs.Msg("imported"), ecs.EventDone(start, err), err) }(time.Now())
+func EventDone(start time.Time, err error) []Field {
+	end := time.Now()
+	outcome := EventOutcomeSuccess
+	if err != nil {
+		outcome = EventOutcomeFailure
+	}
+
+	return []Field{EventStart(start), EventEnd(end), EventDuration(end.Sub(start)), EventOutcome(outcome)}
+}
@@ -0,0 +1,102 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEvent(t *testing.T) {
+	fields := fieldMap(Event(EventKindEvent, EventCategoryAuthentication, EventTypeStart, EventTypeInfo))
+	expected := map[string]interface{}{
+		"event.kind":     "event",
+		"event.category": []string{"authentication"},
+		"event.type":     []string{"start", "info"},
+	}
+
+	if !reflect.DeepEqual(fields, expected) {
+		t.Fatalf("expected %v but got %v", expected, fields)
+	}
+
+	fields = fieldMap(Event("bogus", "bogus", "bogus", EventTypeInfo))
+	if !reflect.DeepEqual(fields, map[string]interface{}{"event.type": []string{"info"}}) {
+		t.Fatalf("expected invalid values to be omitted but got %v", fields)
+	}
+
+	if Event("bogus", "bogus", "bogus") != nil {
+		t.Fatal("expected no fields at all")
+	}
+}
+
+func TestExpectedEventTypes(t *testing.T) {
+	contains := func(category EventCategoryValue, typ EventTypeValue) bool {
+		for _, v := range category.ExpectedEventTypes() {
+			if v == typ {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if !contains(EventCategoryAuthentication, EventTypeStart) || !contains(EventCategoryFile, EventTypeDeletion) {
+		t.Fatal("expected a valid combination")
+	}
+
+	if contains(EventCategoryAuthentication, EventTypeDeletion) || contains(EventCategoryFile, EventTypeStart) {
+		t.Fatal("unexpected invalid combination")
+	}
+
+	if EventCategoryValue("bogus").ExpectedEventTypes() != nil {
+		t.Fatal("expected no types for an invalid category")
+	}
+
+	if !EventKindAlert.Valid() || EventKindValue("bogus").Valid() || EventTypeValue("").Valid() {
+		t.Fatal("unexpected validity")
+	}
+}
+
+func TestEventDone(t *testing.T) {
+	start := time.Now().Add(-time.Second)
+
+	fields := fieldMap(EventDone(start, nil))
+	if fields["event.outcome"] != "success" {
+		t.Fatalf("expected success but got %v", fields["event.outcome"])
+	}
+
+	if d := fields["event.duration"].(int64); d < int64(time.Second) || d > int64(time.Minute) {
+		t.Fatalf("unexpected duration %d", d)
+	}
+
+	if fields["event.start"] != start.Format(time.RFC3339Nano) {
+		t.Fatalf("unexpected start %v", fields["event.start"])
+	}
+
+	if fields := fieldMap(EventDone(start, errors.New("failed"))); fields["event.outcome"] != "failure" {
+		t.Fatalf("expected failure but got %v", fields["event.outcome"])
+	}
+}
+
+func fieldMap(fields []Field) map[string]interface{} {
+	res := map[string]interface{}{}
+	for _, f := range fields {
+		res[f.K] = f.V
+	}
+
+	return res
+}
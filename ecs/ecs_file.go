@@ -0,0 +1,116 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"crypto/md5"  //nolint:gosec
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// File inspects the file at the given path without following symbolic links and returns the fields of FileInfo.
+func File(path string) ([]Field, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot stat file: %w", err)
+	}
+
+	return FileInfo(path, info), nil
+}
+
+// FileInfo returns the file.path, file.name, file.extension and file.directory fields derived from the path and
+// the file.size, file.mtime, file.mode, file.type, file.uid and file.gid fields derived from the info. The
+// file.mime_type is guessed from the extension of regular files. The info may be nil, e.g. if the file has been
+// removed already. The uid and gid are only available on unix systems.
+func FileInfo(path string, info os.FileInfo) []Field {
+	res := []Field{FilePath(path), FileName(filepath.Base(path)), FileDirectory(filepath.Dir(path))}
+
+	ext := strings.TrimPrefix(filepath.Ext(path), ".")
+	if ext != "" {
+		res = append(res, FileExtension(ext))
+	}
+
+	if info == nil {
+		return res
+	}
+
+	res = append(res,
+		FileSize(info.Size()),
+		FileMtime(info.ModTime()),
+		FileMode(fmt.Sprintf("%04o", info.Mode().Perm())),
+	)
+
+	switch {
+	case info.Mode().IsRegular():
+		res = append(res, FileType("file"))
+		if mimeType, _, err := mime.ParseMediaType(mime.TypeByExtension("." + ext)); err == nil && ext != "" {
+			res = append(res, FileMIMEType(mimeType))
+		}
+	case info.IsDir():
+		res = append(res, FileType("dir"))
+	case info.Mode()&os.ModeSymlink != 0:
+		res = append(res, FileType("symlink"))
+	}
+
+	return append(res, fileOwner(info)...)
+}
+
+// A FileHash computes the md5, sha1 and sha256 sums of everything written to it, so that the hashes of a file can
+// be calculated while it is processed anyway, e.g. by using Reader or io.MultiWriter.
+type FileHash struct {
+	md5    hash.Hash
+	sha1   hash.Hash
+	sha256 hash.Hash
+}
+
+// NewFileHash returns a FileHash without any data written yet.
+func NewFileHash() *FileHash {
+	return &FileHash{
+		md5:    md5.New(),  //nolint:gosec
+		sha1:   sha1.New(), //nolint:gosec
+		sha256: sha256.New(),
+	}
+}
+
+// Write updates all sums and never fails.
+func (h *FileHash) Write(p []byte) (int, error) {
+	_, _ = h.md5.Write(p)
+	_, _ = h.sha1.Write(p)
+	_, _ = h.sha256.Write(p)
+
+	return len(p), nil
+}
+
+// Reader returns a reader which writes everything read from r into the FileHash.
+func (h *FileHash) Reader(r io.Reader) io.Reader {
+	return io.TeeReader(r, h)
+}
+
+// Fields returns the file.hash.md5, file.hash.sha1 and file.hash.sha256 fields of the data written so far.
+func (h *FileHash) Fields() []Field {
+	return []Field{
+		FileHashMD5(hex.EncodeToString(h.md5.Sum(nil))),
+		FileHashSHA1(hex.EncodeToString(h.sha1.Sum(nil))),
+		FileHashSHA256(hex.EncodeToString(h.sha256.Sum(nil))),
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ecs")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "hello.txt")
+	if err := ioutil.WriteFile(path, []byte("hello"), 0o640); err != nil {
+		t.Fatal(err)
+	}
+
+	fields, err := File(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"file.path":      path,
+		"file.name":      "hello.txt",
+		"file.extension": "txt",
+		"file.directory": dir,
+		"file.size":      int64(5),
+		"file.mode":      "0640",
+		"file.type":      "file",
+		"file.mime_type": "text/plain",
+	}
+
+	actual := fieldMap(fields)
+	for k, v := range expected {
+		if actual[k] != v {
+			t.Fatalf("expected %s=%v but got %v", k, v, actual[k])
+		}
+	}
+
+	h := NewFileHash()
+	if _, err := io.Copy(ioutil.Discard, h.Reader(strings.NewReader("hello"))); err != nil {
+		t.Fatal(err)
+	}
+
+	sums := fieldMap(h.Fields())
+	if sums["file.hash.md5"] != "5d41402abc4b2a76b9719d911017c592" ||
+		sums["file.hash.sha256"] != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+		t.Fatalf("unexpected sums %v", sums)
+	}
+
+	if _, err := File(filepath.Join(dir, "missing")); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
@@ -0,0 +1,37 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"os"
+	"strconv"
+	"syscall"
+)
+
+// fileOwner returns the file.uid and file.gid fields, if the info originates from the os package.
+func fileOwner(info os.FileInfo) []Field {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil
+	}
+
+	return []Field{
+		FileUID(strconv.FormatUint(uint64(st.Uid), 10)),
+		FileGID(strconv.FormatUint(uint64(st.Gid), 10)),
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// SniffLen is the amount of body bytes considered by http.DetectContentType.
+const SniffLen = 512
+
+// HTTPResponse contains the metadata of a response, which cannot be inspected from the http.ResponseWriter itself.
+type HTTPResponse struct {
+	// StatusCode is the written status code. If zero, no response fields are derived.
+	StatusCode int
+	// Header contains the response headers.
+	Header http.Header
+	// BodyBytes is the amount of written body bytes.
+	BodyBytes int64
+	// Sniff contains the first bytes of the body, up to SniffLen, to detect http.response.mime_type.
+	Sniff []byte
+}
+
+// HTTPOptions configure which optional fields are derived by HTTP.
+type HTTPOptions struct {
+	// RequestID is the http.request.id, which is omitted if empty.
+	RequestID string
+	// RequestSniff contains the first bytes of the request body, up to SniffLen, to detect http.request.mime_type.
+	RequestSniff []byte
+	// Headers is the allow-list of case insensitive header names, which are captured as http.request.headers and
+	// http.response.headers. If empty, no headers are captured at all.
+	Headers []string
+	// Redact is applied to each captured header value, if not nil.
+	Redact func(key, value string) string
+}
+
+// HTTP derives the ECS http group from the request and the response metadata, so that a single call covers
+// http.version, http.request.method, http.request.id, http.request.bytes, http.request.body.bytes,
+// http.request.referrer, http.request.mime_type, http.response.status_code, http.response.bytes,
+// http.response.body.bytes, http.response.mime_type and the captured headers. Empty values are omitted.
+// The total bytes are approximated from the request line, the headers and the body length. The mime types are
+// detected from the body, as ECS demands, and not taken from the Content-Type header.
+func HTTP(r *http.Request, res HTTPResponse, opts HTTPOptions) []Field {
+	fields := []Field{
+		HTTPVersion(httpVersion(r.Proto, r.ProtoMajor, r.ProtoMinor)),
+		HTTPRequestMethod(r.Method),
+	}
+
+	if opts.RequestID != "" {
+		fields = append(fields, HTTPRequestID(opts.RequestID))
+	}
+
+	if r.ContentLength >= 0 {
+		line := len(r.Method) + len(r.URL.RequestURI()) + len(r.Proto) + 4
+		host := len("Host: ") + len(r.Host) + 2
+		fields = append(fields,
+			HTTPRequestBytes(int64(line+host+headerBytes(r.Header))+r.ContentLength),
+			HTTPRequestBodyBytes(r.ContentLength),
+		)
+	}
+
+	if ref := r.Referer(); ref != "" {
+		fields = append(fields, HTTPRequestReferrer(ref))
+	}
+
+	if len(opts.RequestSniff) > 0 {
+		fields = append(fields, HTTPRequestMIMEType(mimeType(opts.RequestSniff)))
+	}
+
+	if h := allowedHeaders(r.Header, opts); len(h) > 0 {
+		fields = append(fields, HTTPRequestHeaders(h))
+	}
+
+	if res.StatusCode == 0 {
+		return fields
+	}
+
+	line := len(r.Proto) + len(strconv.Itoa(res.StatusCode)) + len(http.StatusText(res.StatusCode)) + 4
+	fields = append(fields,
+		HTTPResponseStatusCode(res.StatusCode),
+		HTTPResponseBytes(int64(line+headerBytes(res.Header))+res.BodyBytes),
+		HTTPResponseBodyBytes(res.BodyBytes),
+	)
+
+	if len(res.Sniff) > 0 {
+		fields = append(fields, HTTPResponseMIMEType(mimeType(res.Sniff)))
+	}
+
+	if h := allowedHeaders(res.Header, opts); len(h) > 0 {
+		fields = append(fields, HTTPResponseHeaders(h))
+	}
+
+	return fields
+}
+
+// httpVersion returns the version without the HTTP/ prefix, like 1.1 or 2.0.
+func httpVersion(proto string, major, minor int) string {
+	if v := strings.TrimPrefix(proto, "HTTP/"); v != proto && v != "" {
+		return v
+	}
+
+	return fmt.Sprintf("%d.%d", major, minor)
+}
+
+// headerBytes approximates the wire size of the header lines including the terminating empty line.
+func headerBytes(h http.Header) int {
+	n := 2
+	for k, values := range h {
+		for _, v := range values {
+			n += len(k) + len(v) + 4
+		}
+	}
+
+	return n
+}
+
+// mimeType detects the content type and removes parameters like the charset.
+func mimeType(sniff []byte) string {
+	if len(sniff) > SniffLen {
+		sniff = sniff[:SniffLen]
+	}
+
+	ct := http.DetectContentType(sniff)
+	if i := strings.IndexByte(ct, ';'); i >= 0 {
+		ct = ct[:i]
+	}
+
+	return ct
+}
+
+// allowedHeaders returns the allowed and redacted headers. Multiple values are joined by a comma.
+func allowedHeaders(h http.Header, opts HTTPOptions) map[string]string {
+	if len(opts.Headers) == 0 || len(h) == 0 {
+		return nil
+	}
+
+	res := map[string]string{}
+	for _, name := range opts.Headers {
+		name = http.CanonicalHeaderKey(name)
+		values, ok := h[name]
+		if !ok {
+			continue
+		}
+
+		v := strings.Join(values, ",")
+		if opts.Redact != nil {
+			v = opts.Redact(name, v)
+		}
+
+		res[name] = v
+	}
+
+	return res
+}
+
+// HTTPRequestMethod is the http request method, like GET or POST. The key is "http.request.method".
+func HTTPRequestMethod(method string) Field {
+	return Field{
+		K: "http.request.method",
+		V: method,
+	}
+}
+
+// HTTPRequestID is a unique identifier for each http request to correlate logs. The key is "http.request.id".
+func HTTPRequestID(id string) Field {
+	return Field{
+		K: "http.request.id",
+		V: id,
+	}
+}
+
+// HTTPResponseStatusCode is the http response status code. The key is "http.response.status_code".
+func HTTPResponseStatusCode(code int) Field {
+	return Field{
+		K: "http.response.status_code",
+		V: code,
+	}
+}
+
+// HTTPResponseBodyBytes is the size in bytes of the response body. The key is "http.response.body.bytes".
+func HTTPResponseBodyBytes(n int64) Field {
+	return Field{
+		K: "http.response.body.bytes",
+		V: n,
+	}
+}
+
+// HTTPRequestBodyContent is the full or truncated request body. The key is "http.request.body.content".
+func HTTPRequestBodyContent(content string) Field {
+	return Field{
+		K: "http.request.body.content",
+		V: content,
+	}
+}
+
+// HTTPResponseBodyContent is the full or truncated response body. The key is "http.response.body.content".
+func HTTPResponseBodyContent(content string) Field {
+	return Field{
+		K: "http.response.body.content",
+		V: content,
+	}
+}
+
+// HTTPRequestHeaders contains the captured request headers. This is not defined by ECS. The key is
+// "http.request.headers".
+func HTTPRequestHeaders(headers map[string]string) Field {
+	return Field{
+		K: "http.request.headers",
+		V: headers,
+	}
+}
+
+// HTTPResponseHeaders contains the captured response headers. This is not defined by ECS. The key is
+// "http.response.headers".
+func HTTPResponseHeaders(headers map[string]string) Field {
+	return Field{
+		K: "http.response.headers",
+		V: headers,
+	}
+}
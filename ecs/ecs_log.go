@@ -79,3 +79,30 @@ func Panic() Field {
 		V: "panic",
 	}
 }
+
+// LogOriginFileName is the name of the file containing the source code which originated the log event. The key
+// is "log.origin.file.name".
+func LogOriginFileName(name string) Field {
+	return Field{
+		K: "log.origin.file.name",
+		V: name,
+	}
+}
+
+// LogOriginFileLine is the line number of the file containing the source code which originated the log event.
+// The key is "log.origin.file.line".
+func LogOriginFileLine(line int) Field {
+	return Field{
+		K: "log.origin.file.line",
+		V: line,
+	}
+}
+
+// LogOriginFunction is the name of the function or method which originated the log event. The key is
+// "log.origin.function".
+func LogOriginFunction(fn string) Field {
+	return Field{
+		K: "log.origin.function",
+		V: fn,
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint:gosec // required by the community id specification
+	"encoding/base64"
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// The field sets, which describe an endpoint of a network connection.
+const (
+	setSource      = "source"
+	setDestination = "destination"
+	setClient      = "client"
+	setServer      = "server"
+)
+
+// The network directions of a connection from the perspective of this process.
+const (
+	DirectionIngress = "ingress"
+	DirectionEgress  = "egress"
+)
+
+// SourceAddr returns source.address, source.ip or source.domain and source.port of the address.
+func SourceAddr(a net.Addr) []Field {
+	return addrFields(setSource, a)
+}
+
+// DestinationAddr returns destination.address, destination.ip or destination.domain and destination.port of the
+// address.
+func DestinationAddr(a net.Addr) []Field {
+	return addrFields(setDestination, a)
+}
+
+// ClientAddr returns client.address, client.ip or client.domain and client.port of the address.
+func ClientAddr(a net.Addr) []Field {
+	return addrFields(setClient, a)
+}
+
+// ServerAddr returns server.address, server.ip or server.domain and server.port of the address.
+func ServerAddr(a net.Addr) []Field {
+	return addrFields(setServer, a)
+}
+
+// SourceAddrPort returns source.address, source.ip and source.port.
+func SourceAddrPort(ap netip.AddrPort) []Field {
+	return addrPortFields(setSource, ap)
+}
+
+// DestinationAddrPort returns destination.address, destination.ip and destination.port.
+func DestinationAddrPort(ap netip.AddrPort) []Field {
+	return addrPortFields(setDestination, ap)
+}
+
+// ClientAddrPort returns client.address, client.ip and client.port.
+func ClientAddrPort(ap netip.AddrPort) []Field {
+	return addrPortFields(setClient, ap)
+}
+
+// ServerAddrPort returns server.address, server.ip and server.port.
+func ServerAddrPort(ap netip.AddrPort) []Field {
+	return addrPortFields(setServer, ap)
+}
+
+// InboundConn describes a connection accepted by this process: the remote address is the client and the source,
+// the local address is the server and the destination and the network.direction is ingress. It also contains
+// network.transport, network.type and network.community_id, if the addresses are ip addresses.
+func InboundConn(c net.Conn) []Field {
+	return connFields(c.RemoteAddr(), c.LocalAddr(), DirectionIngress)
+}
+
+// OutboundConn describes a connection dialed by this process: the local address is the client and the source,
+// the remote address is the server and the destination and the network.direction is egress. See also
+// InboundConn.
+func OutboundConn(c net.Conn) []Field {
+	return connFields(c.LocalAddr(), c.RemoteAddr(), DirectionEgress)
+}
+
+// Flow returns the source.*, destination.*, network.transport, network.type, network.direction and
+// network.community_id fields of a flow from src to dst. The transport is like tcp or udp and the direction is
+// omitted, if empty.
+func Flow(src, dst netip.AddrPort, transport, direction string) []Field {
+	res := append(addrPortFields(setSource, src), addrPortFields(setDestination, dst)...)
+
+	return append(res, networkFields(src, dst, transport, direction)...)
+}
+
+// CommunityID returns the version 1 community id of the flow using the given seed, see
+// https://github.com/corelight/community-id-spec. The transport is tcp, udp or sctp. An empty string is returned
+// for other transports or invalid addresses. ICMP is not supported, because its id is derived from the message
+// type and code instead of ports.
+func CommunityID(seed uint16, src, dst netip.AddrPort, transport string) string {
+	proto, ok := protocols[transport]
+	if !ok || !src.IsValid() || !dst.IsValid() {
+		return ""
+	}
+
+	srcIP, dstIP := src.Addr().Unmap().AsSlice(), dst.Addr().Unmap().AsSlice()
+	srcPort, dstPort := src.Port(), dst.Port()
+
+	if c := bytes.Compare(srcIP, dstIP); c > 0 || (c == 0 && srcPort > dstPort) {
+		srcIP, dstIP = dstIP, srcIP
+		srcPort, dstPort = dstPort, srcPort
+	}
+
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.BigEndian, seed)
+	buf.Write(srcIP)
+	buf.Write(dstIP)
+	buf.WriteByte(proto)
+	buf.WriteByte(0)
+	_ = binary.Write(&buf, binary.BigEndian, srcPort)
+	_ = binary.Write(&buf, binary.BigEndian, dstPort)
+
+	sum := sha1.Sum(buf.Bytes()) //nolint:gosec
+
+	return "1:" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+//nolint:gochecknoglobals
+var protocols = map[string]byte{"tcp": 6, "udp": 17, "sctp": 132}
+
+// connFields describes a connection from src, the client, to dst, the server.
+func connFields(src, dst net.Addr, direction string) []Field {
+	res := append(addrFields(setSource, src), addrFields(setDestination, dst)...)
+	res = append(res, addrFields(setClient, src)...)
+	res = append(res, addrFields(setServer, dst)...)
+
+	srcAP, srcOK := addrPort(src)
+	dstAP, dstOK := addrPort(dst)
+	if srcOK && dstOK {
+		return append(res, networkFields(srcAP, dstAP, transport(src.Network()), direction)...)
+	}
+
+	return append(res, NetworkDirection(direction))
+}
+
+// networkFields returns network.transport, network.type, network.direction and network.community_id.
+func networkFields(src, dst netip.AddrPort, transport, direction string) []Field {
+	var res []Field
+	if transport != "" {
+		res = append(res, NetworkTransport(transport))
+	}
+
+	if src.Addr().Unmap().Is4() {
+		res = append(res, NetworkType("ipv4"))
+	} else if src.Addr().Is6() {
+		res = append(res, NetworkType("ipv6"))
+	}
+
+	if direction != "" {
+		res = append(res, NetworkDirection(direction))
+	}
+
+	if id := CommunityID(0, src, dst, transport); id != "" {
+		res = append(res, NetworkCommunityID(id))
+	}
+
+	return res
+}
+
+// addrFields splits the address into ip or domain and port. Unix sockets and unknown addresses are only
+// reported as address.
+func addrFields(set string, a net.Addr) []Field {
+	if a == nil {
+		return nil
+	}
+
+	if ap, ok := addrPort(a); ok {
+		return addrPortFields(set, ap)
+	}
+
+	host, port, err := net.SplitHostPort(a.String())
+	if err != nil {
+		return []Field{{K: set + ".address", V: a.String()}}
+	}
+
+	res := []Field{{K: set + ".address", V: host}, {K: set + ".domain", V: host}}
+	if p, err := strconv.Atoi(port); err == nil {
+		res = append(res, Field{K: set + ".port", V: p})
+	}
+
+	return res
+}
+
+// addrPortFields returns the address, the ip and the port. IPv4 mapped IPv6 addresses are unmapped.
+func addrPortFields(set string, ap netip.AddrPort) []Field {
+	if !ap.IsValid() {
+		return nil
+	}
+
+	ip := ap.Addr().Unmap().String()
+	res := []Field{{K: set + ".address", V: ip}, {K: set + ".ip", V: ip}}
+	if ap.Port() != 0 {
+		res = append(res, Field{K: set + ".port", V: int(ap.Port())})
+	}
+
+	return res
+}
+
+// addrPort converts tcp, udp and ip addresses.
+func addrPort(a net.Addr) (netip.AddrPort, bool) {
+	switch t := a.(type) {
+	case *net.TCPAddr:
+		return t.AddrPort(), t.IP != nil
+	case *net.UDPAddr:
+		return t.AddrPort(), t.IP != nil
+	case *net.IPAddr:
+		ip, ok := netip.AddrFromSlice(t.IP)
+
+		return netip.AddrPortFrom(ip, 0), ok
+	default:
+		ap, err := netip.ParseAddrPort(a.String())
+
+		return ap, err == nil
+	}
+}
+
+// transport maps a network of net.Addr, like tcp4 or udp6, to the transport.
+func transport(network string) string {
+	switch {
+	case strings.HasPrefix(network, "tcp"):
+		return "tcp"
+	case strings.HasPrefix(network, "udp"):
+		return "udp"
+	case network == "ip:icmp" || network == "ip4:icmp":
+		return "icmp"
+	case network == "ip6:ipv6-icmp":
+		return "icmp6"
+	default:
+		return ""
+	}
+}
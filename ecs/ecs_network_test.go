@@ -0,0 +1,71 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+)
+
+func TestCommunityID(t *testing.T) {
+	src := netip.MustParseAddrPort("128.232.110.120:34855")
+	dst := netip.MustParseAddrPort("66.35.250.204:80")
+
+	// the first flow of the tcp baseline of the specification
+	const expected = "1:LQU9qZlK+B5F3KDmev6m5PMibrg="
+	if id := CommunityID(0, src, dst, "tcp"); id != expected {
+		t.Fatalf("expected %s but got %s", expected, id)
+	}
+
+	if id := CommunityID(0, dst, src, "tcp"); id != expected {
+		t.Fatalf("expected a direction independent id but got %s", id)
+	}
+
+	if id := CommunityID(0, src, dst, "icmp"); id != "" {
+		t.Fatalf("expected no id for icmp but got %s", id)
+	}
+}
+
+func TestInboundConn(t *testing.T) {
+	local := &net.TCPAddr{IP: net.ParseIP("::ffff:10.0.0.1"), Port: 443}
+	remote := &net.TCPAddr{IP: net.ParseIP("192.168.1.7"), Port: 50123}
+
+	values := map[string]interface{}{}
+	for _, f := range connFields(remote, local, DirectionIngress) {
+		values[f.K] = f.V
+	}
+
+	expected := map[string]interface{}{
+		"source.ip":         "192.168.1.7",
+		"source.port":       50123,
+		"destination.ip":    "10.0.0.1",
+		"client.ip":         "192.168.1.7",
+		"server.port":       443,
+		"network.transport": "tcp",
+		"network.type":      "ipv4",
+		"network.direction": "ingress",
+	}
+
+	for k, v := range expected {
+		if values[k] != v {
+			t.Errorf("expected %s=%v but got %v", k, v, values[k])
+		}
+	}
+
+	if values["network.community_id"] == nil {
+		t.Fatal("expected a community id")
+	}
+}
@@ -14,7 +14,8 @@
 
 package ecs
 
-// ServerAddress is ambiguous, may be the host name or the ip. The key is "server.address".
+// ServerAddress is ambiguous, may be the host name or the ip. Use ServerAddr or ServerAddrPort to derive the ip,
+// the domain and the port correctly. The key is "server.address".
 func ServerAddress(adr string) Field {
 	return Field{
 		K: "server.address",
@@ -22,7 +23,7 @@ func ServerAddress(adr string) Field {
 	}
 }
 
-// ServerAddress is the server domain. The key is "server.domain".
+// ServerDomain is the server domain. The key is "server.domain".
 func ServerDomain(adr string) Field {
 	return Field{
 		K: "server.domain",
@@ -30,7 +31,7 @@ func ServerDomain(adr string) Field {
 	}
 }
 
-// ServerIp is the server ip. The key is "server.ip".
+// ServerIp is the server ip. The key is "server.ip". See also ServerAddr and ServerAddrPort.
 func ServerIp(adr string) Field {
 	return Field{
 		K: "server.ip",
@@ -38,7 +39,7 @@ func ServerIp(adr string) Field {
 	}
 }
 
-// ServerPort is the server ip. The key is "server.port".
+// ServerPort is the server port. The key is "server.port".
 func ServerPort(port int) Field {
 	return Field{
 		K: "server.port",
@@ -0,0 +1,112 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// TLSInbound returns the tls.* fields of a connection accepted by this process, e.g. http.Request.TLS. The peer
+// certificate, if any, belongs to the client and is described by the tls.client.* fields.
+func TLSInbound(cs *tls.ConnectionState) []Field {
+	return tlsFields(cs, "tls.client.")
+}
+
+// TLSOutbound returns the tls.* fields of a connection dialed by this process, e.g. http.Response.TLS. The peer
+// certificate belongs to the server and is described by the tls.server.* fields.
+func TLSOutbound(cs *tls.ConnectionState) []Field {
+	return tlsFields(cs, "tls.server.")
+}
+
+// tlsFields returns tls.version, tls.version_protocol, tls.cipher, tls.established, tls.resumed,
+// tls.next_protocol, tls.client.server_name and the certificate fields of the peer.
+func tlsFields(cs *tls.ConnectionState, peer string) []Field {
+	if cs == nil {
+		return nil
+	}
+
+	protocol, version := tlsVersion(cs.Version)
+	res := []Field{
+		TLSVersion(version),
+		TLSVersionProtocol(protocol),
+		TLSCipher(tls.CipherSuiteName(cs.CipherSuite)),
+		TLSEstablished(cs.HandshakeComplete),
+		TLSResumed(cs.DidResume),
+	}
+
+	if cs.NegotiatedProtocol != "" {
+		res = append(res, TLSNextProtocol(cs.NegotiatedProtocol))
+	}
+
+	if cs.ServerName != "" {
+		res = append(res, TLSClientServerName(cs.ServerName))
+	}
+
+	if len(cs.PeerCertificates) > 0 {
+		res = append(res, certificateFields(peer, cs.PeerCertificates[0])...)
+	}
+
+	return res
+}
+
+// certificateFields describes the leaf certificate of the peer.
+func certificateFields(prefix string, cert *x509.Certificate) []Field {
+	sum := sha256.Sum256(cert.Raw)
+
+	res := []Field{
+		{K: prefix + "x509.subject.distinguished_name", V: cert.Subject.String()},
+		{K: prefix + "x509.issuer.distinguished_name", V: cert.Issuer.String()},
+		{K: prefix + "hash.sha256", V: strings.ToUpper(hex.EncodeToString(sum[:]))},
+		{K: prefix + "not_before", V: cert.NotBefore.Format(time.RFC3339Nano)},
+		{K: prefix + "not_after", V: cert.NotAfter.Format(time.RFC3339Nano)},
+	}
+
+	if cert.Subject.CommonName != "" {
+		res = append(res, Field{K: prefix + "x509.subject.common_name", V: []string{cert.Subject.CommonName}})
+	}
+
+	if cert.Issuer.CommonName != "" {
+		res = append(res, Field{K: prefix + "x509.issuer.common_name", V: []string{cert.Issuer.CommonName}})
+	}
+
+	if cert.SerialNumber != nil {
+		res = append(res, Field{K: prefix + "x509.serial_number", V: strings.ToUpper(cert.SerialNumber.Text(16))})
+	}
+
+	return res
+}
+
+// tlsVersion splits the protocol version into the protocol name and the version number, like tls and 1.3.
+func tlsVersion(v uint16) (protocol, version string) {
+	switch v {
+	case tls.VersionSSL30: //nolint:staticcheck
+		return "ssl", "3.0"
+	case tls.VersionTLS10:
+		return "tls", "1.0"
+	case tls.VersionTLS11:
+		return "tls", "1.1"
+	case tls.VersionTLS12:
+		return "tls", "1.2"
+	case tls.VersionTLS13:
+		return "tls", "1.3"
+	default:
+		return "tls", ""
+	}
+}
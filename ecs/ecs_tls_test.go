@@ -0,0 +1,71 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTLS(t *testing.T) {
+	var inbound []Field
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		inbound = TLSInbound(r.TLS)
+	}))
+	defer srv.Close()
+
+	res, err := srv.Client().Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = res.Body.Close()
+
+	outbound := fieldMap(TLSOutbound(res.TLS))
+	expected := map[string]interface{}{
+		"tls.version_protocol": "tls",
+		"tls.version":          "1.3",
+		"tls.established":      true,
+		"tls.resumed":          false,
+		"tls.server.x509.issuer.distinguished_name": "O=Acme Co",
+	}
+
+	for k, v := range expected {
+		if outbound[k] != v {
+			t.Fatalf("expected %s=%v but got %v", k, v, outbound[k])
+		}
+	}
+
+	if hash, _ := outbound["tls.server.hash.sha256"].(string); len(hash) != 64 || strings.ToUpper(hash) != hash {
+		t.Fatalf("unexpected certificate hash %q", hash)
+	}
+
+	if cipher := fieldMap(inbound)["tls.cipher"]; cipher != tls.CipherSuiteName(res.TLS.CipherSuite) {
+		t.Fatalf("unexpected cipher %v", cipher)
+	}
+
+	for k := range fieldMap(inbound) {
+		if strings.HasPrefix(k, "tls.client.x509") {
+			t.Fatalf("unexpected client certificate field %s", k)
+		}
+	}
+
+	if TLSInbound(nil) != nil {
+		t.Fatal("expected no fields without tls")
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+// TraceID is the unique identifier of the trace, which groups multiple events like transactions
+// belonging together. The key is "trace.id".
+func TraceID(id string) Field {
+	return Field{
+		K: "trace.id",
+		V: id,
+	}
+}
+
+// SpanID is the unique identifier of the span within the scope of its trace. The key is "span.id".
+func SpanID(id string) Field {
+	return Field{
+		K: "span.id",
+		V: id,
+	}
+}
@@ -14,6 +14,27 @@
 
 package ecs
 
+import (
+	"github.com/golangee/log/field"
+	"net/url"
+)
+
+// URL decomposes the url into the ECS url group, like url.full, url.domain, url.registered_domain or url.path.
+// The password is always removed, see also field.URLFields.
+func URL(u *url.URL) []Field {
+	return field.URLFields(u, "")
+}
+
+// URLString parses and decomposes the url like URL. If it cannot be parsed, only url.original is returned.
+func URLString(s string) []Field {
+	u, err := url.Parse(s)
+	if err != nil {
+		return []Field{URLOriginal(s)}
+	}
+
+	return field.URLFields(u, s)
+}
+
 // Path of the request. The key name is "url.path".
 func URLPath(p string) Field {
 	return Field{
@@ -21,3 +42,21 @@ func URLPath(p string) Field {
 		V: p,
 	}
 }
+
+// URLQuery is the query field describes the query string of the request, without the leading "?". The key
+// name is "url.query".
+func URLQuery(q string) Field {
+	return Field{
+		K: "url.query",
+		V: q,
+	}
+}
+
+// URLFull is the full url, if it is available. Note that credentials must never be logged and must be removed
+// before. The key name is "url.full".
+func URLFull(u string) Field {
+	return Field{
+		K: "url.full",
+		V: u,
+	}
+}
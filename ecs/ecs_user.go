@@ -0,0 +1,56 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+// User returns the user.id, user.name, user.email, user.domain and user.roles fields of the user who performed
+// an action. Empty values are omitted. Use privacy.Pseudonymize to avoid logging personal data in plain text.
+func User(id, name, email, domain string, roles ...string) []Field {
+	var res []Field
+	if id != "" {
+		res = append(res, UserID(id))
+	}
+
+	if name != "" {
+		res = append(res, UserName(name))
+	}
+
+	if email != "" {
+		res = append(res, UserEmail(email))
+	}
+
+	if domain != "" {
+		res = append(res, UserDomain(domain))
+	}
+
+	if len(roles) > 0 {
+		res = append(res, UserRoles(roles...))
+	}
+
+	return res
+}
+
+// Organization returns the organization.id and organization.name fields, omitting empty values.
+func Organization(id, name string) []Field {
+	var res []Field
+	if id != "" {
+		res = append(res, OrganizationID(id))
+	}
+
+	if name != "" {
+		res = append(res, OrganizationName(name))
+	}
+
+	return res
+}
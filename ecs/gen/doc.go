@@ -0,0 +1,25 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command gen generates the field constructors, key constants and allowed value enums of the ecs package and the
+// definitions of the ecs/schema package and the lint module from the vendored ECS flat field definitions
+// ecs_flat.yml. The file is copied from generated/ecs/ecs_flat.yml of https://github.com/elastic/ecs, which is
+// licensed under the Apache License 2.0 as well.
+//
+// Constructors are not generated for names which are already declared by hand or for keys which are already
+// used by a hand written constructor, so the generated code only fills the gaps. To update to a new ECS version,
+// replace ecs_flat.yml, adjust the version in the go:generate directive of the ecs package and run
+//
+//	go generate github.com/golangee/log/ecs
+package main
@@ -0,0 +1,148 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// definition is an entry of ecs_flat.yml.
+type definition struct {
+	FlatName      string         `yaml:"flat_name"`
+	Type          string         `yaml:"type"`
+	ObjectType    string         `yaml:"object_type"`
+	Description   string         `yaml:"description"`
+	Example       interface{}    `yaml:"example"`
+	Normalize     []string       `yaml:"normalize"`
+	AllowedValues []allowedValue `yaml:"allowed_values"`
+}
+
+// allowedValue is an entry of the allowed_values of a definition.
+type allowedValue struct {
+	Name               string   `yaml:"name"`
+	Description        string   `yaml:"description"`
+	ExpectedEventTypes []string `yaml:"expected_event_types"`
+}
+
+// array returns true, if the field is expected to contain an array of values.
+func (d definition) array() bool {
+	for _, n := range d.Normalize {
+		if n == "array" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func main() {
+	in := flag.String("in", "ecs_flat.yml", "the ECS flat field definitions")
+	out := flag.String("out", "..", "the directory of the ecs package")
+	schemaDir := flag.String("schema", "../schema", "the directory of the ecs/schema package")
+	lintDir := flag.String("lint", "../../lint", "the directory of the lint package, which embeds a copy of the schema")
+	version := flag.String("version", "", "the ECS version of the definitions")
+	flag.Parse()
+
+	if err := run(*in, *out, *schemaDir, *lintDir, *version); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(in, out, schemaDir, lintDir, version string) error {
+	if version == "" {
+		return fmt.Errorf("the ECS version is required")
+	}
+
+	buf, err := ioutil.ReadFile(in) //nolint:gosec
+	if err != nil {
+		return err
+	}
+
+	var defs map[string]definition
+	if err := yaml.Unmarshal(buf, &defs); err != nil {
+		return fmt.Errorf("cannot parse %s: %w", in, err)
+	}
+
+	fields := make([]definition, 0, len(defs))
+	for _, d := range defs {
+		fields = append(fields, d)
+	}
+
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].FlatName < fields[j].FlatName
+	})
+
+	hand, err := scan(out)
+	if err != nil {
+		return err
+	}
+
+	files, err := render(fields, hand, version)
+	if err != nil {
+		return err
+	}
+
+	stale, err := filepath.Glob(filepath.Join(out, "*"+generatedSuffix))
+	if err != nil {
+		return err
+	}
+
+	for _, name := range stale {
+		if err := os.Remove(name); err != nil {
+			return err
+		}
+	}
+
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(out, name), src, 0o644); err != nil { //nolint:gosec
+			return err
+		}
+	}
+
+	schema := tsv(fields, version)
+	for _, dir := range []string{schemaDir, lintDir} {
+		if err := ioutil.WriteFile(filepath.Join(dir, "fields.tsv"), schema, 0o644); err != nil { //nolint:gosec
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tsv renders the definitions for the schema and the lint package: the name, the type and the comma separated
+// allowed values, delimited by tabs.
+func tsv(fields []definition, version string) []byte {
+	sb := &strings.Builder{}
+	sb.WriteString("# ECS " + version + " field definitions: name, type and allowed values, generated by ecs/gen.\n")
+
+	for _, f := range fields {
+		allowed := make([]string, 0, len(f.AllowedValues))
+		for _, a := range f.AllowedValues {
+			allowed = append(allowed, a.Name)
+		}
+
+		sb.WriteString(f.FlatName + "\t" + f.Type + "\t" + strings.Join(allowed, ",") + "\n")
+	}
+
+	return []byte(sb.String())
+}
@@ -0,0 +1,88 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strings"
+	"unicode"
+)
+
+// initialisms are written in upper case, like in the hand written constructors.
+//
+//nolint:gochecknoglobals
+var initialisms = map[string]string{
+	"acl": "ACL", "api": "API", "as": "AS", "cpu": "CPU", "dns": "DNS", "ecs": "ECS", "elf": "ELF", "faas": "FaaS",
+	"gid": "GID", "html": "HTML", "http": "HTTP", "https": "HTTPS", "iam": "IAM", "icmp": "ICMP", "id": "ID",
+	"ids": "IDs", "io": "IO", "ip": "IP", "ja3": "JA3", "ja3s": "JA3S", "ja4": "JA4", "json": "JSON", "mac": "MAC",
+	"md5": "MD5", "mime": "MIME", "os": "OS", "pe": "PE", "pid": "PID", "ppid": "PPID", "rpc": "RPC",
+	"sha1": "SHA1", "sha256": "SHA256", "sha384": "SHA384", "sha512": "SHA512", "smtp": "SMTP", "sql": "SQL",
+	"ssh": "SSH", "tcp": "TCP", "tls": "TLS", "tlsh": "TLSH", "ttl": "TTL", "tty": "TTY", "udp": "UDP",
+	"uid": "UID", "uri": "URI", "url": "URL", "uuid": "UUID", "vcs": "VCS", "x509": "X509",
+}
+
+// identifier converts a flat field name or an allowed value into an exported Go identifier, e.g.
+// http.request.id becomes HTTPRequestID and pipeline_error becomes PipelineError.
+func identifier(s string) string {
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	sb := &strings.Builder{}
+	for _, p := range parts {
+		if v, ok := initialisms[strings.ToLower(p)]; ok {
+			sb.WriteString(v)
+
+			continue
+		}
+
+		runes := []rune(p)
+		sb.WriteRune(unicode.ToUpper(runes[0]))
+		sb.WriteString(string(runes[1:]))
+	}
+
+	return sb.String()
+}
+
+// firstParagraph returns the first paragraph of a description as a single line.
+func firstParagraph(s string) string {
+	if i := strings.Index(s, "\n\n"); i >= 0 {
+		s = s[:i]
+	}
+
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// comment wraps the text into line comments with the given indent, so that no line exceeds the width.
+func comment(text, indent string, width int) string {
+	sb := &strings.Builder{}
+	line := 0
+	for _, word := range strings.Fields(text) {
+		switch {
+		case line == 0:
+			sb.WriteString(indent + "// " + word)
+			line = len(indent) + 3 + len(word)
+		case line+1+len(word) > width:
+			sb.WriteString("\n" + indent + "// " + word)
+			line = len(indent) + 3 + len(word)
+		default:
+			sb.WriteString(" " + word)
+			line += 1 + len(word)
+		}
+	}
+
+	sb.WriteString("\n")
+
+	return sb.String()
+}
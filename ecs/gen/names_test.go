@@ -0,0 +1,34 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import "testing"
+
+func TestIdentifier(t *testing.T) {
+	tests := map[string]string{
+		"http.request.id":       "HTTPRequestID",
+		"@timestamp":            "Timestamp",
+		"pipeline_error":        "PipelineError",
+		"file.hash.sha256":      "FileHashSHA256",
+		"faas.trigger.type":     "FaaSTriggerType",
+		"user_agent.os.version": "UserAgentOSVersion",
+	}
+
+	for in, want := range tests {
+		if got := identifier(in); got != want {
+			t.Errorf("%s: expected %s but got %s", in, want, got)
+		}
+	}
+}
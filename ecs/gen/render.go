@@ -0,0 +1,342 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// width is the maximum length of a generated comment line.
+const width = 120
+
+const header = `// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+`
+
+// signature describes the parameters of a constructor and the expression of the value.
+type signature struct {
+	params string
+	value  string
+	imp    string
+}
+
+// file collects the declarations of a single field set.
+type file struct {
+	keys    strings.Builder
+	decls   strings.Builder
+	imports map[string]bool
+}
+
+// generator remembers the generated names to detect collisions.
+type generator struct {
+	hand      handWritten
+	generated map[string]string
+}
+
+// declare returns false, if the name is declared by hand and fails, if it has already been generated for another
+// field.
+func (g *generator) declare(name, key string) (bool, error) {
+	if g.hand.names[name] {
+		return false, nil
+	}
+
+	if other, ok := g.generated[name]; ok {
+		return false, fmt.Errorf("%s of %s collides with %s", name, key, other)
+	}
+
+	g.generated[name] = key
+
+	return true, nil
+}
+
+// render returns the formatted sources by file name.
+func render(fields []definition, hand handWritten, version string) (map[string][]byte, error) {
+	g := &generator{hand: hand, generated: map[string]string{}}
+	files := map[string]*file{}
+
+	for _, d := range fields {
+		set := fieldSet(d.FlatName)
+		f := files[set]
+		if f == nil {
+			f = &file{imports: map[string]bool{}}
+			files[set] = f
+		}
+
+		if err := g.field(f, d); err != nil {
+			return nil, err
+		}
+	}
+
+	res := map[string][]byte{}
+	for set, f := range files {
+		src, err := f.source(set, version)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", set, err)
+		}
+
+		res["ecs_"+set+generatedSuffix] = src
+	}
+
+	return res, nil
+}
+
+// field generates the key constant, the allowed values and the constructor.
+func (g *generator) field(f *file, d definition) error {
+	name := identifier(d.FlatName)
+	key := "Key" + name
+
+	ok, err := g.declare(key, d.FlatName)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		fmt.Fprintf(&f.keys, "\t%s = %q\n", key, d.FlatName)
+	}
+
+	enum := ""
+	if len(d.AllowedValues) > 0 {
+		if enum, err = g.enum(f, name, d); err != nil {
+			return err
+		}
+	}
+
+	if g.hand.keys[d.FlatName] || g.hand.keys[key] {
+		return nil
+	}
+
+	sig, ok := signatureOf(d, enum)
+	if !ok {
+		return nil
+	}
+
+	if ok, err := g.declare(name, d.FlatName); err != nil || !ok {
+		return err
+	}
+
+	if sig.imp != "" {
+		f.imports[sig.imp] = true
+	}
+
+	text := fmt.Sprintf("%s creates the %q field of type %s. %s", name, d.FlatName, d.Type,
+		firstParagraph(d.Description))
+	if ex := fmt.Sprint(d.Example); d.Example != nil && len(ex) <= 60 && !strings.Contains(ex, "\n") {
+		text += " Example: " + ex
+	}
+
+	f.decls.WriteString("\n" + comment(text, "", width))
+	fmt.Fprintf(&f.decls, "func %s(%s) Field {\n\treturn Field{\n\t\tK: %s,\n\t\tV: %s,\n\t}\n}\n", name, sig.params,
+		key, sig.value)
+
+	return nil
+}
+
+// enum generates a string type and a constant for each allowed value and returns the type name, which is empty,
+// if the type is declared by hand.
+func (g *generator) enum(f *file, name string, d definition) (string, error) {
+	typ := name + "Value"
+
+	ok, err := g.declare(typ, d.FlatName)
+	if err != nil || !ok {
+		return "", err
+	}
+
+	fmt.Fprintf(&f.decls, "\n// %s is one of the allowed values of the %q field.\ntype %s string\n", typ, d.FlatName,
+		typ)
+	fmt.Fprintf(&f.decls, "\n// The allowed values of the %q field.\nconst (\n", d.FlatName)
+
+	for i, v := range d.AllowedValues {
+		c := name + identifier(v.Name)
+
+		ok, err := g.declare(c, d.FlatName)
+		if err != nil {
+			return "", err
+		}
+
+		if !ok {
+			continue
+		}
+
+		if i > 0 {
+			f.decls.WriteString("\n")
+		}
+
+		f.decls.WriteString(comment(fmt.Sprintf("%s is %q. %s", c, v.Name, firstParagraph(v.Description)), "\t",
+			width))
+		fmt.Fprintf(&f.decls, "\t%s %s = %q\n", c, typ, v.Name)
+	}
+
+	f.decls.WriteString(")\n")
+	validator(f, name, typ, d)
+	expectedEventTypes(f, name, typ, d)
+
+	if d.array() {
+		fn := stringsFunc(typ)
+		fmt.Fprintf(&f.decls, "\n// %s converts the values into plain strings.\nfunc %s(v []%s) []string {\n", fn, fn, typ)
+		f.decls.WriteString("\tres := make([]string, 0, len(v))\n\tfor _, s := range v {\n" +
+			"\t\tres = append(res, string(s))\n\t}\n\n\treturn res\n}\n")
+	}
+
+	return typ, nil
+}
+
+// validator generates the Valid method of an enum type.
+func validator(f *file, name, typ string, d definition) {
+	values := make([]string, 0, len(d.AllowedValues))
+	for _, v := range d.AllowedValues {
+		values = append(values, name+identifier(v.Name))
+	}
+
+	fmt.Fprintf(&f.decls, "\n// Valid returns true, if v is one of the allowed values.\nfunc (v %s) Valid() bool {\n", typ)
+	fmt.Fprintf(&f.decls, "\tswitch v {\n\tcase %s:\n\t\treturn true\n\tdefault:\n\t\treturn false\n\t}\n}\n",
+		strings.Join(values, ", "))
+}
+
+// expectedEventTypes generates the ExpectedEventTypes method, if the allowed values declare the event types,
+// which are expected to be used together with them, like for event.category.
+func expectedEventTypes(f *file, name, typ string, d definition) {
+	found := false
+	for _, v := range d.AllowedValues {
+		found = found || len(v.ExpectedEventTypes) > 0
+	}
+
+	if !found {
+		return
+	}
+
+	eventType := identifier("event.type") + "Value"
+	fmt.Fprintf(&f.decls, "\n// ExpectedEventTypes returns the values of the \"event.type\" field, which are expected "+
+		"to be used together\n// with v.\nfunc (v %s) ExpectedEventTypes() []%s {\n\tswitch v {\n", typ, eventType)
+
+	for _, v := range d.AllowedValues {
+		if len(v.ExpectedEventTypes) == 0 {
+			continue
+		}
+
+		types := make([]string, 0, len(v.ExpectedEventTypes))
+		for _, t := range v.ExpectedEventTypes {
+			types = append(types, identifier("event.type")+identifier(t))
+		}
+
+		fmt.Fprintf(&f.decls, "\tcase %s:\n\t\treturn []%s{%s}\n", name+identifier(v.Name), eventType,
+			strings.Join(types, ", "))
+	}
+
+	f.decls.WriteString("\tdefault:\n\t\treturn nil\n\t}\n}\n")
+}
+
+// signatureOf maps the ECS type to a Go type. Fields which are normalized to arrays become variadic.
+func signatureOf(d definition, enum string) (signature, bool) {
+	variadic := ""
+	if d.array() {
+		variadic = "..."
+	}
+
+	switch d.Type {
+	case "keyword", "constant_keyword", "wildcard", "match_only_text", "text":
+		switch {
+		case enum != "" && variadic != "":
+			return signature{params: "v ..." + enum, value: stringsFunc(enum) + "(v)"}, true
+		case enum != "":
+			return signature{params: "v " + enum, value: "string(v)"}, true
+		}
+
+		return signature{params: "v " + variadic + "string", value: "v"}, true
+	case "long":
+		return signature{params: "v int64", value: "v"}, true
+	case "float", "scaled_float":
+		return signature{params: "v float64", value: "v"}, true
+	case "boolean":
+		return signature{params: "v bool", value: "v"}, true
+	case "ip":
+		if variadic != "" {
+			return signature{params: "v ...net.IP", value: "ipStrings(v)", imp: "net"}, true
+		}
+
+		return signature{params: "v net.IP", value: "v.String()", imp: "net"}, true
+	case "date":
+		return signature{params: "v time.Time", value: "v.Format(time.RFC3339Nano)", imp: "time"}, true
+	case "geo_point":
+		return signature{params: "lat, lon float64", value: `map[string]float64{"lat": lat, "lon": lon}`}, true
+	case "object", "flattened":
+		if d.ObjectType == "keyword" {
+			return signature{params: "v " + variadic + "map[string]string", value: "v"}, true
+		}
+
+		return signature{params: "v " + variadic + "map[string]interface{}", value: "v"}, true
+	case "nested":
+		return signature{params: "v ...map[string]interface{}", value: "v"}, true
+	default:
+		return signature{}, false
+	}
+}
+
+// stringsFunc returns the name of the unexported function, which converts a slice of the enum type into strings.
+func stringsFunc(typ string) string {
+	return strings.ToLower(typ[:1]) + typ[1:] + "Strings"
+}
+
+// fieldSet returns the name of the field set, which is the first segment or base for the base fields.
+func fieldSet(name string) string {
+	set := strings.SplitN(name, ".", 2)[0]
+	if set == name {
+		return "base"
+	}
+
+	return set
+}
+
+// source assembles and formats the file.
+func (f *file) source(set, version string) ([]byte, error) {
+	sb := &strings.Builder{}
+	sb.WriteString(header)
+	sb.WriteString("\n// Code generated by ecs/gen from ECS " + version + ". DO NOT EDIT.\n\npackage ecs\n")
+
+	if len(f.imports) > 0 {
+		imports := make([]string, 0, len(f.imports))
+		for imp := range f.imports {
+			imports = append(imports, fmt.Sprintf("%q", imp))
+		}
+
+		sort.Strings(imports)
+		sb.WriteString("\nimport (\n\t" + strings.Join(imports, "\n\t") + "\n)\n")
+	}
+
+	if set == "base" {
+		sb.WriteString("\n// Version is the ECS version of the generated fields.\nconst Version = \"" + version + "\"\n")
+	}
+
+	if f.keys.Len() > 0 {
+		fmt.Fprintf(sb, "\n// The keys of the %s fields.\nconst (\n%s)\n", set, f.keys.String())
+	}
+
+	sb.WriteString(f.decls.String())
+
+	return format.Source([]byte(sb.String()))
+}
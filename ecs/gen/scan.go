@@ -0,0 +1,105 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// generatedSuffix is the file name suffix of the generated files, which are ignored by scan.
+const generatedSuffix = "_generated.go"
+
+// handWritten contains the top level names and the field keys of the hand written code.
+type handWritten struct {
+	names map[string]bool
+	// keys contains the string literals and the identifiers used as K in a composite literal.
+	keys map[string]bool
+}
+
+// scan parses the hand written, non-test files of the package.
+func scan(dir string) (handWritten, error) {
+	hand := handWritten{names: map[string]bool{}, keys: map[string]bool{}}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return hand, err
+	}
+
+	fset := token.NewFileSet()
+	for _, name := range files {
+		if strings.HasSuffix(name, generatedSuffix) || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			return hand, err
+		}
+
+		declared(file, hand.names)
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			kv, ok := n.(*ast.KeyValueExpr)
+			if !ok {
+				return true
+			}
+
+			if k, ok := kv.Key.(*ast.Ident); !ok || k.Name != "K" {
+				return true
+			}
+
+			switch v := kv.Value.(type) {
+			case *ast.BasicLit:
+				if s, err := strconv.Unquote(v.Value); err == nil {
+					hand.keys[s] = true
+				}
+			case *ast.Ident:
+				hand.keys[v.Name] = true
+			}
+
+			return true
+		})
+	}
+
+	return hand, nil
+}
+
+// declared collects the top level names of the file.
+func declared(file *ast.File, names map[string]bool) {
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				names[d.Name.Name] = true
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					names[s.Name.Name] = true
+				case *ast.ValueSpec:
+					for _, n := range s.Names {
+						names[n.Name] = true
+					}
+				}
+			}
+		}
+	}
+}
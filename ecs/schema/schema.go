@@ -0,0 +1,242 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	_ "embed" // for the field definitions
+	"encoding"
+	"fmt"
+	"github.com/golangee/log/ecs"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Version is the ECS version of the embedded field definitions.
+const Version = ecs.Version
+
+//go:embed fields.tsv
+var fieldsTSV string //nolint:gochecknoglobals
+
+//nolint:gochecknoglobals
+var (
+	loadOnce    sync.Once
+	definitions map[string]Definition
+)
+
+// Definition describes a single ECS field.
+type Definition struct {
+	// Name is the flat name, like "error.message".
+	Name string
+	// Type is the Elasticsearch field type, like keyword, long, date or object.
+	Type string
+	// Allowed contains the expected values, if the field is restricted, like event.outcome.
+	Allowed []string
+}
+
+// container returns true for the object, flattened and nested types, whose sub keys are not defined by ECS.
+func (d Definition) container() bool {
+	switch d.Type {
+	case "object", "flattened", "nested":
+		return true
+	default:
+		return false
+	}
+}
+
+// Violation describes a field which does not conform to ECS.
+type Violation struct {
+	Key    string
+	Value  interface{}
+	Reason string
+}
+
+// Error returns a human readable description.
+func (v *Violation) Error() string {
+	return fmt.Sprintf("ECS field '%s': %s", v.Key, v.Reason)
+}
+
+// Lookup returns the definition of the given flat key. Keys below an object, flattened or nested field, like
+// labels.my_key, are reported by the definition of that parent.
+func Lookup(key string) (Definition, bool) {
+	loadOnce.Do(load)
+
+	if d, ok := definitions[key]; ok {
+		return d, true
+	}
+
+	for i := strings.LastIndexByte(key, '.'); i > 0; i = strings.LastIndexByte(key[:i], '.') {
+		if d, ok := definitions[key[:i]]; ok && d.container() {
+			return d, true
+		}
+	}
+
+	return Definition{}, false
+}
+
+// Check validates the key and the type of the value and returns a *Violation, if the key is unknown, the value
+// does not fit into the declared type or is not one of the allowed values. Like in Elasticsearch, each field may
+// also contain a slice of values. A nil value is always valid.
+func Check(key string, value interface{}) error {
+	if v := check(key, value); v != nil {
+		return v
+	}
+
+	return nil
+}
+
+func check(key string, value interface{}) *Violation {
+	d, ok := Lookup(key)
+	if !ok {
+		return &Violation{Key: key, Value: value, Reason: "unknown field" + suggest(key)}
+	}
+
+	if value == nil {
+		return nil
+	}
+
+	rv := reflect.ValueOf(value)
+	if d.Name == key && !d.container() && isList(rv) {
+		for i := 0; i < rv.Len(); i++ {
+			if v := checkValue(d, key, rv.Index(i)); v != nil {
+				return v
+			}
+		}
+
+		return nil
+	}
+
+	return checkValue(d, key, rv)
+}
+
+// checkValue validates a single value.
+func checkValue(d Definition, key string, rv reflect.Value) *Violation {
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+
+		rv = rv.Elem()
+	}
+
+	if d.Name != key || matches(d.Type, rv) {
+		return checkAllowed(d, rv)
+	}
+
+	return &Violation{
+		Key:    key,
+		Value:  rv.Interface(),
+		Reason: fmt.Sprintf("expected %s but got %s", d.Type, rv.Type()),
+	}
+}
+
+// checkAllowed validates strings against the allowed values, if any.
+func checkAllowed(d Definition, rv reflect.Value) *Violation {
+	if len(d.Allowed) == 0 || rv.Kind() != reflect.String {
+		return nil
+	}
+
+	for _, a := range d.Allowed {
+		if a == rv.String() {
+			return nil
+		}
+	}
+
+	return &Violation{
+		Key:    d.Name,
+		Value:  rv.String(),
+		Reason: fmt.Sprintf("'%s' is not one of %s", rv.String(), strings.Join(d.Allowed, ", ")),
+	}
+}
+
+// matches returns true, if the value is serialized compatible to the Elasticsearch type.
+func matches(typ string, rv reflect.Value) bool {
+	textMarshaler := rv.Type().Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem())
+
+	switch typ {
+	case "keyword", "constant_keyword", "wildcard", "match_only_text", "text", "ip", "version":
+		return rv.Kind() == reflect.String || textMarshaler
+	case "long", "integer", "short", "byte", "unsigned_long":
+		return isInt(rv.Kind())
+	case "float", "half_float", "scaled_float", "double":
+		return isInt(rv.Kind()) || rv.Kind() == reflect.Float32 || rv.Kind() == reflect.Float64
+	case "boolean":
+		return rv.Kind() == reflect.Bool
+	case "date":
+		return rv.Kind() == reflect.String || textMarshaler || isInt(rv.Kind())
+	case "object", "flattened", "nested":
+		return rv.Kind() == reflect.Map || rv.Kind() == reflect.Struct || isList(rv)
+	default: // e.g. geo_point, which has too many representations
+		return true
+	}
+}
+
+func isInt(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// isList returns true for arrays and slices, except byte slices.
+func isList(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Array, reflect.Slice:
+		return rv.Type().Elem().Kind() != reflect.Uint8
+	default:
+		return false
+	}
+}
+
+// suggest returns a hint for keys which only differ in case from a known field.
+func suggest(key string) string {
+	lower := strings.ToLower(key)
+	if lower == key {
+		return ""
+	}
+
+	if _, ok := Lookup(lower); ok {
+		return fmt.Sprintf(", did you mean '%s'?", lower)
+	}
+
+	return ""
+}
+
+// load parses the embedded definitions. Each line contains the name, the type and the comma separated allowed
+// values, delimited by tabs. Lines starting with # are comments.
+func load() {
+	definitions = make(map[string]Definition, strings.Count(fieldsTSV, "\n"))
+
+	for _, line := range strings.Split(fieldsTSV, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		cols := strings.Split(line, "\t")
+		if len(cols) < 2 {
+			continue
+		}
+
+		d := Definition{Name: cols[0], Type: cols[1]}
+		if len(cols) > 2 && cols[2] != "" {
+			d.Allowed = strings.Split(cols[2], ",")
+		}
+
+		definitions[d.Name] = d
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"errors"
+	"github.com/golangee/log/ecs"
+	"github.com/golangee/log/field"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		key   string
+		value interface{}
+		want  string
+	}{
+		{"error.message", "boom", ""},
+		{"http.response.status_code", 200, ""},
+		{"event.duration", time.Second, ""},
+		{"tags", []string{"a", "b"}, ""},
+		{"labels.my_key", "value", ""},
+		{"@timestamp", time.Now(), ""},
+		{"event.outcome", "success", ""},
+		{"error.mesage", "boom", "unknown field"},
+		{"url.Path", "/", "did you mean 'url.path'?"},
+		{"http.response.status_code", "200", "expected long but got string"},
+		{"tags", map[string]string{"a": "b"}, "expected keyword"},
+		{"event.outcome", "ok", "'ok' is not one of failure, success, unknown"},
+	}
+
+	for _, tt := range tests {
+		err := Check(tt.key, tt.value)
+		if tt.want == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected %v", tt.key, err)
+			}
+
+			continue
+		}
+
+		var v *Violation
+		if !errors.As(err, &v) || !strings.Contains(v.Error(), tt.want) {
+			t.Errorf("%s: expected %q but got %v", tt.key, tt.want, err)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var events [][]field.DefaultField
+	logger := Validate(func(fields ...interface{}) {
+		events = append(events, field.Fields(fields...))
+	}, Options{Allow: []string{"my.key"}})
+
+	for i := 0; i < 3; i++ {
+		logger(ecs.Msg("hello"), field.DefaultField{K: "error.mesage", V: "x"}, field.DefaultField{K: "my.key", V: 1},
+			ecs.LogOriginFileLine(42))
+	}
+
+	// 3 events and a single warning
+	if len(events) != 4 {
+		t.Fatalf("expected 4 events but got %d: %v", len(events), events)
+	}
+
+	warning := events[1]
+	if warning[1].V != LoggerName || !strings.Contains(warning[2].V.(string), "error.mesage") {
+		t.Fatalf("unexpected warning %v", warning)
+	}
+
+	if warning[3] != ecs.LogOriginFileLine(42) {
+		t.Fatalf("expected origin but got %v", warning)
+	}
+
+	defer func() {
+		if _, ok := recover().(*Violation); !ok {
+			t.Fatal("expected a violation panic")
+		}
+	}()
+
+	Validate(func(fields ...interface{}) {}, Options{Panic: true})(field.DefaultField{K: "url.Path", V: "/"})
+}
@@ -0,0 +1,142 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"github.com/golangee/log/ecs"
+	"github.com/golangee/log/field"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LoggerName is the log.logger of the warnings emitted by Validate.
+const LoggerName = "golangee.log.schema"
+
+// maxReported limits the memory of the rate limiter.
+const maxReported = 1024
+
+// Extensions contains keys and key prefixes, which end with a dot, of fields emitted by this module which are not
+// defined by ECS. They are always accepted by Validate.
+//
+//nolint:gochecknoglobals
+var Extensions = []string{
+	"error.causes",
+	"error.stack_frames",
+	"http.request.headers",
+	"http.response.headers",
+	"rpc.",
+}
+
+// Options configure Validate.
+type Options struct {
+	// Allow contains additional keys and key prefixes, which end with a dot, to accept.
+	Allow []string
+	// Interval is the minimum duration between two warnings for the same key. Zero means one minute.
+	Interval time.Duration
+	// Panic panics with the *Violation instead of emitting a warning, which is intended for tests.
+	Panic bool
+}
+
+// validator remembers when a key has been reported the last time.
+type validator struct {
+	opts     Options
+	mutex    sync.Mutex
+	reported map[string]time.Time
+}
+
+// Validate returns a logger function, which checks each resolved field of an event using Check and passes the
+// event unchanged to next. For each violation, a warning event is emitted afterwards to next, which contains the
+// log.origin fields of the offending event, if any. Warnings are rate limited per key, see Options.Interval.
+func Validate(next func(fields ...interface{}), opts Options) func(fields ...interface{}) {
+	if opts.Interval == 0 {
+		opts.Interval = time.Minute
+	}
+
+	v := &validator{opts: opts, reported: map[string]time.Time{}}
+
+	return func(fields ...interface{}) {
+		resolved := field.Fields(fields...)
+		var violations []*Violation
+		for _, f := range resolved {
+			if v.allowed(f.K) {
+				continue
+			}
+
+			if violation := check(f.K, f.V); violation != nil {
+				if opts.Panic {
+					panic(violation)
+				}
+
+				violations = append(violations, violation)
+			}
+		}
+
+		next(fields...)
+
+		for _, violation := range violations {
+			if v.report(violation.Key) {
+				next(warning(violation, resolved)...)
+			}
+		}
+	}
+}
+
+// allowed returns true, if the key is an extension or explicitly allowed.
+func (v *validator) allowed(key string) bool {
+	return matchAny(Extensions, key) || matchAny(v.opts.Allow, key)
+}
+
+// report returns true, if the key has not been reported within the interval.
+func (v *validator) report(key string) bool {
+	now := time.Now()
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if last, ok := v.reported[key]; ok && now.Sub(last) < v.opts.Interval {
+		return false
+	}
+
+	if len(v.reported) >= maxReported {
+		v.reported = map[string]time.Time{}
+	}
+
+	v.reported[key] = now
+
+	return true
+}
+
+// warning creates the warning event and copies the origin of the offending event.
+func warning(violation *Violation, event []field.DefaultField) []interface{} {
+	res := []interface{}{ecs.Warn(), ecs.Log(LoggerName), ecs.Msg(violation.Error())}
+	for _, f := range event {
+		if strings.HasPrefix(f.K, "log.origin.") {
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
+func matchAny(patterns []string, key string) bool {
+	for _, p := range patterns {
+		if p == key || (strings.HasSuffix(p, ".") && strings.HasPrefix(key, p)) {
+			return true
+		}
+	}
+
+	return false
+}
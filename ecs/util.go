@@ -1,5 +1,7 @@
 package ecs
 
+import "net"
+
 // with is a copy and breaks the cycle
 func with(next func(fields ...interface{}), more ...func() interface{}) func(fields ...interface{}) {
 	return func(fields ...interface{}) {
@@ -26,3 +28,20 @@ func WithTime(next func(fields ...interface{})) func(fields ...interface{}) {
 		return Time
 	})
 }
+
+// WithTimeFormat returns a new logger function which always prepends the time, formatted with the given layout.
+func WithTimeFormat(next func(fields ...interface{}), layout string) func(fields ...interface{}) {
+	return with(next, func() interface{} {
+		return TimeFormat(layout)
+	})
+}
+
+// ipStrings converts the addresses into their string representation.
+func ipStrings(ips []net.IP) []string {
+	res := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		res = append(res, ip.String())
+	}
+
+	return res
+}
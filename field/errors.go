@@ -0,0 +1,151 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package field
+
+import (
+	"errors"
+	"github.com/golangee/log/stack"
+	"reflect"
+)
+
+// MaxErrorDepth limits how deep an error chain is walked, to protect against cyclic or degenerated chains.
+const MaxErrorDepth = 32
+
+// MaxErrorCauses limits how many errors of a tree are visited in total, because a cyclic tree with fan-out
+// would otherwise be walked exponentially often within MaxErrorDepth.
+const MaxErrorCauses = 256
+
+// Provider is an optional interface for errors, which carry their own structured fields.
+// Fields collects the fields of all providers found in an error chain.
+type Provider interface {
+	// Fields returns the fields to log additionally.
+	Fields() []Field
+}
+
+// Cause describes a single error of an unwrapped error chain.
+type Cause struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+}
+
+// String returns the type and message.
+func (c Cause) String() string {
+	return c.Type + ": " + c.Message
+}
+
+// RootCause returns the innermost error, by following errors.Unwrap and the first error of Unwrap() []error
+// up to MaxErrorDepth.
+func RootCause(err error) error {
+	for i := 0; i < MaxErrorDepth && err != nil; i++ {
+		next := unwrap(err)
+		if len(next) == 0 || next[0] == nil {
+			return err
+		}
+
+		err = next[0]
+	}
+
+	return err
+}
+
+// Causes walks depth-first through the entire error tree, including joined errors, and returns all
+// contained errors in visiting order, starting with err itself. Each branch is walked up to MaxErrorDepth and
+// at most MaxErrorCauses errors are returned.
+func Causes(err error) []error {
+	var res []error
+
+	var walk func(err error, depth int)
+	walk = func(err error, depth int) {
+		if err == nil || depth >= MaxErrorDepth || len(res) >= MaxErrorCauses {
+			return
+		}
+
+		res = append(res, err)
+		for _, e := range unwrap(err) {
+			walk(e, depth+1)
+		}
+	}
+
+	walk(err, 0)
+
+	return res
+}
+
+// unwrap returns the wrapped error or the joined errors.
+func unwrap(err error) []error {
+	if u, ok := err.(interface{ Unwrap() []error }); ok {
+		return u.Unwrap()
+	}
+
+	if e := errors.Unwrap(err); e != nil {
+		return []error{e}
+	}
+
+	return nil
+}
+
+// errorFields creates the ECS fields for error.message and error.type, where the type is taken from the root
+// cause. If the error is a chain, an additional "error.causes" field is added, containing a Cause slice.
+// If any error in the chain carries a stack trace (see stack.Of), the innermost one is added as
+// "error.stack_trace". Finally, the fields of any Provider in the chain are appended.
+func errorFields(err error) []DefaultField {
+	res := []DefaultField{
+		{
+			K: "error.message", // ecs standard
+			V: err.Error(),
+		},
+		{
+			K: "error.type", // ecs standard
+			V: reflect.TypeOf(RootCause(err)).String(),
+		},
+	}
+
+	causes := Causes(err)
+	if len(causes) > 1 {
+		tmp := make([]Cause, 0, len(causes)-1)
+		for _, e := range causes[1:] {
+			tmp = append(tmp, Cause{Message: e.Error(), Type: reflect.TypeOf(e).String()})
+		}
+
+		res = append(res, DefaultField{
+			K: "error.causes",
+			V: tmp,
+		})
+	}
+
+	for i := len(causes) - 1; i >= 0; i-- {
+		if trace, ok := stack.Of(causes[i]); ok {
+			res = append(res, DefaultField{
+				K: "error.stack_trace", // ecs standard
+				V: trace.Filter(stack.Internal).String(),
+			})
+
+			break
+		}
+	}
+
+	for _, e := range causes {
+		if p, ok := e.(Provider); ok {
+			for _, f := range p.Fields() {
+				res = append(res, DefaultField{
+					K: f.Key(),
+					V: f.Value(),
+				})
+			}
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package field
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type codeError struct{}
+
+func (codeError) Error() string {
+	return "code error"
+}
+
+func (codeError) Fields() []Field {
+	return []Field{DefaultField{K: "error.code", V: "E42"}}
+}
+
+type cyclicError struct{}
+
+func (e *cyclicError) Error() string {
+	return "cyclic"
+}
+
+func (e *cyclicError) Unwrap() error {
+	return e
+}
+
+type forkError struct{}
+
+func (e *forkError) Error() string {
+	return "fork"
+}
+
+func (e *forkError) Unwrap() []error {
+	return []error{e, e}
+}
+
+func value(fields []DefaultField, key string) interface{} {
+	for _, f := range fields {
+		if f.K == key {
+			return f.V
+		}
+	}
+
+	return nil
+}
+
+func TestErrorChain(t *testing.T) {
+	err := fmt.Errorf("outer: %w", fmt.Errorf("middle: %w", codeError{}))
+	fields := Fields(err)
+
+	if v := value(fields, "error.type"); v != "field.codeError" {
+		t.Fatalf("expected root cause type but got %v", v)
+	}
+
+	if v := value(fields, "error.causes").([]Cause); len(v) != 2 {
+		t.Fatalf("expected 2 causes but got %v", v)
+	}
+
+	if v := value(fields, "error.code"); v != "E42" {
+		t.Fatalf("expected provided field but got %v", v)
+	}
+
+	joined := fmt.Errorf("%w and %w", errors.New("a"), codeError{})
+	if v := value(Fields(joined), "error.code"); v != "E42" {
+		t.Fatalf("expected provided field from joined error but got %v", v)
+	}
+
+	if causes := Causes(&cyclicError{}); len(causes) != MaxErrorDepth {
+		t.Fatalf("expected depth limit but got %d", len(causes))
+	}
+
+	// would visit 2^32 nodes without a total limit
+	if causes := Causes(&forkError{}); len(causes) != MaxErrorCauses {
+		t.Fatalf("expected total limit but got %d", len(causes))
+	}
+}
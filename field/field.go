@@ -16,8 +16,7 @@ package field
 
 import (
 	"fmt"
-	"reflect"
-	"strings"
+	"net/url"
 	"time"
 )
 
@@ -53,7 +52,9 @@ func (f DefaultField) String() string {
 
 // Fields type casts the given interfaces or wraps them into multiple ECS compatible field types.
 // It may return more fields than arguments, because it may logically parse or split an argument,
-// like deriving error type and error message from an error.
+// like deriving error type and error message from an error. Errors are unwrapped, see also Causes and Provider.
+// A slice of fields, like a group returned by a single constructor, is flattened. Urls and strings which look like
+// absolute urls are decomposed, see URLFields.
 func Fields(v ...interface{}) []DefaultField {
 	res := make([]DefaultField, 0, len(v))
 	for _, f := range v {
@@ -62,6 +63,8 @@ func Fields(v ...interface{}) []DefaultField {
 			res = append(res, t)
 		case *DefaultField:
 			res = append(res, *t)
+		case []DefaultField:
+			res = append(res, t...)
 		case func() DefaultField:
 			res = append(res, t())
 		case Field:
@@ -70,15 +73,7 @@ func Fields(v ...interface{}) []DefaultField {
 				V: t.Value(),
 			})
 		case error:
-			res = append(res, DefaultField{
-				K: "error.message", // ecs standard
-				V: t.Error(),
-			})
-
-			res = append(res, DefaultField{
-				K: "error.type", // ecs standard
-				V: reflect.TypeOf(t).String(),
-			})
+			res = append(res, errorFields(t)...)
 		case string:
 			switch t {
 			case "info":
@@ -95,11 +90,8 @@ func Fields(v ...interface{}) []DefaultField {
 					V: t,
 				})
 			default:
-				if strings.HasPrefix(t, "http") {
-					res = append(res, DefaultField{
-						K: "url.path", // ecs standard
-						V: t,
-					})
+				if u, ok := parseURL(t); ok {
+					res = append(res, URLFields(u, t)...)
 				} else {
 					res = append(res, DefaultField{
 						K: "message", // ecs standard
@@ -107,6 +99,10 @@ func Fields(v ...interface{}) []DefaultField {
 					})
 				}
 			}
+		case *url.URL:
+			res = append(res, URLFields(t, "")...)
+		case url.URL:
+			res = append(res, URLFields(&t, "")...)
 		case time.Time:
 			res = append(res, DefaultField{
 				K: "@timestamp", // ecs standard
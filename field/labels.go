@@ -0,0 +1,87 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package field
+
+import (
+	"sort"
+	"strings"
+)
+
+// LabelsPrefix is the key prefix of flattened labels, like labels.env.
+const LabelsPrefix = "labels."
+
+// LabelKey replaces the dots in a custom label key by underscores, because ECS does not allow nested labels.
+func LabelKey(key string) string {
+	return strings.ReplaceAll(key, ".", "_")
+}
+
+// FlattenLabels replaces each labels field, whose value is a map, by one labels.<key> field per entry, ordered by
+// key, so that flat encoders can merge and print them like any other field. Other fields are kept in order.
+func FlattenLabels(fields []DefaultField) []DefaultField {
+	var res []DefaultField
+	for i, f := range fields {
+		labels, ok := labelMap(f)
+		if !ok {
+			if res != nil {
+				res = append(res, f)
+			}
+
+			continue
+		}
+
+		if res == nil {
+			res = make([]DefaultField, 0, len(fields)+len(labels))
+			res = append(res, fields[:i]...)
+		}
+
+		keys := make([]string, 0, len(labels))
+		for k := range labels {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			res = append(res, DefaultField{K: LabelsPrefix + LabelKey(k), V: labels[k]})
+		}
+	}
+
+	if res == nil {
+		return fields
+	}
+
+	return res
+}
+
+// labelMap returns the entries of a labels field.
+func labelMap(f DefaultField) (map[string]interface{}, bool) {
+	if f.K != "labels" {
+		return nil, false
+	}
+
+	switch t := f.V.(type) {
+	case map[string]string:
+		res := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			res[k] = v
+		}
+
+		return res, true
+	case map[string]interface{}:
+		return t, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package field
+
+import (
+	"github.com/golangee/log/internal/publicsuffix"
+	"net"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// URLFields decomposes the url into the ECS fields url.original, url.full, url.scheme, url.domain,
+// url.registered_domain, url.subdomain, url.top_level_domain, url.port, url.path, url.query, url.fragment,
+// url.username and url.extension. Empty values are omitted. The original is the given string or, if empty, the
+// string representation of u. Passwords are always removed, also from the original.
+func URLFields(u *url.URL, original string) []DefaultField {
+	if u == nil {
+		return nil
+	}
+
+	if original == "" {
+		original = u.String()
+	}
+
+	tmp := *u
+	if u.User != nil {
+		tmp.User = url.User(u.User.Username())
+	}
+
+	res := []DefaultField{
+		{K: "url.original", V: stripPassword(original)},
+		{K: "url.full", V: tmp.String()},
+	}
+
+	add := func(k, v string) {
+		if v != "" {
+			res = append(res, DefaultField{K: k, V: v})
+		}
+	}
+
+	add("url.scheme", u.Scheme)
+
+	if host := u.Hostname(); host != "" {
+		add("url.domain", host)
+
+		if net.ParseIP(host) == nil {
+			sub, registered, suffix := publicsuffix.Split(host)
+			add("url.registered_domain", registered)
+			add("url.subdomain", sub)
+			add("url.top_level_domain", suffix)
+		}
+	}
+
+	if port, err := strconv.Atoi(u.Port()); err == nil {
+		res = append(res, DefaultField{K: "url.port", V: port})
+	}
+
+	add("url.path", u.Path)
+	add("url.query", u.RawQuery)
+	add("url.fragment", u.Fragment)
+
+	if u.User != nil {
+		add("url.username", u.User.Username())
+	}
+
+	add("url.extension", strings.TrimPrefix(path.Ext(u.Path), "."))
+
+	return res
+}
+
+// parseURL returns the url, if s looks like an absolute url with a scheme and a host, like https://host/x?y=1.
+func parseURL(s string) (*url.URL, bool) {
+	i := strings.Index(s, "://")
+	if i <= 0 || strings.ContainsAny(s, " \t\n") {
+		return nil, false
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, false
+	}
+
+	return u, true
+}
+
+// stripPassword removes the password from the user info of the url string, without touching anything else.
+func stripPassword(s string) string {
+	start := strings.Index(s, "://")
+	if start < 0 {
+		return s
+	}
+
+	start += 3
+	end := len(s)
+	if i := strings.IndexAny(s[start:], "/?#"); i >= 0 {
+		end = start + i
+	}
+
+	at := strings.LastIndexByte(s[start:end], '@')
+	if at < 0 {
+		return s
+	}
+
+	colon := strings.IndexByte(s[start:start+at], ':')
+	if colon < 0 {
+		return s
+	}
+
+	return s[:start+colon] + s[start+at:]
+}
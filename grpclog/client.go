@@ -0,0 +1,137 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpclog
+
+import (
+	"context"
+	"github.com/golangee/log"
+	"github.com/golangee/log/ecs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"io"
+	"sync"
+	"time"
+)
+
+// UnaryClientInterceptor logs one event per unary RPC using the logger from log.FromContext.
+func UnaryClientInterceptor(opts Options) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		start := time.Now()
+		logger := clientLogger(ctx, method, cc)
+
+		err := invoker(ctx, method, req, reply, cc, callOpts...)
+		logger.Println(finish(start, err)...)
+
+		return err
+	}
+}
+
+// StreamClientInterceptor logs one event per streaming RPC using the logger from log.FromContext. The event is
+// emitted when the stream has been finished, which is when a receive returns an error, including io.EOF, when
+// the single response of a client streaming RPC has been received or when the context of the caller is done.
+// If enabled, each message is logged as well.
+func StreamClientInterceptor(opts Options) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, callOpts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		logger := clientLogger(ctx, method, cc)
+
+		cs, err := streamer(ctx, desc, cc, method, callOpts...)
+		if err != nil {
+			logger.Println(finish(start, err)...)
+
+			return nil, err
+		}
+
+		s := &clientStream{
+			ClientStream:  cs,
+			start:         start,
+			logger:        logger,
+			logMessages:   opts.LogMessages,
+			serverStreams: desc.ServerStreams,
+		}
+
+		// the stream context is also done, when the stream has been finished regularly, which is logged by
+		// RecvMsg. A canceled caller may never receive again, so that case is logged here.
+		go func() {
+			<-cs.Context().Done()
+
+			if err := ctx.Err(); err != nil {
+				s.finish(status.FromContextError(err).Err())
+			}
+		}()
+
+		return s, nil
+	}
+}
+
+// clientLogger creates the logger with the rpc and destination fields.
+func clientLogger(ctx context.Context, method string, cc *grpc.ClientConn) log.Logger {
+	fields := methodFields(method)
+	if cc != nil {
+		fields = append(fields, ecs.DestinationAddress(cc.Target()))
+	}
+
+	return log.WithFields(log.FromContext(ctx), fields...)
+}
+
+// clientStream logs the final event exactly once.
+type clientStream struct {
+	grpc.ClientStream
+	start       time.Time
+	logger      log.Logger
+	logMessages bool
+	// serverStreams is false, if the server responds with a single message, which finishes the RPC.
+	serverStreams bool
+	once          sync.Once
+}
+
+func (s *clientStream) SendMsg(m interface{}) error {
+	err := s.ClientStream.SendMsg(m)
+	if s.logMessages {
+		logMessage(s.logger, "sent", err)
+	}
+
+	if err != nil && err != io.EOF {
+		s.finish(err)
+	}
+
+	return err
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if s.logMessages && err != io.EOF {
+		logMessage(s.logger, "received", err)
+	}
+
+	switch {
+	case err == io.EOF:
+		s.finish(nil)
+	case err != nil:
+		s.finish(err)
+	case !s.serverStreams:
+		s.finish(nil)
+	}
+
+	return err
+}
+
+func (s *clientStream) finish(err error) {
+	s.once.Do(func() {
+		s.logger.Println(finish(s.start, err)...)
+	})
+}
@@ -0,0 +1,18 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpclog provides gRPC server and client interceptors, which log one ECS event per RPC and put a request
+// scoped logger into the context, so that handlers can just use log.FromContext. It is a separate module, to keep
+// the core logging facade free of dependencies.
+package grpclog
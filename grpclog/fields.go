@@ -0,0 +1,112 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpclog
+
+import (
+	"context"
+	"github.com/golangee/log/ecs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"strings"
+)
+
+// rpcSystem is always grpc. The key is "rpc.system".
+func rpcSystem() ecs.Field {
+	return ecs.Field{
+		K: "rpc.system",
+		V: "grpc",
+	}
+}
+
+// rpcService is the full service name, like "grpc.health.v1.Health". The key is "rpc.service".
+func rpcService(service string) ecs.Field {
+	return ecs.Field{
+		K: "rpc.service",
+		V: service,
+	}
+}
+
+// rpcMethod is the short method name, like "Check". The key is "rpc.method".
+func rpcMethod(method string) ecs.Field {
+	return ecs.Field{
+		K: "rpc.method",
+		V: method,
+	}
+}
+
+// rpcStatusCode is the numeric gRPC status code. The key is "rpc.grpc.status_code".
+func rpcStatusCode(code codes.Code) ecs.Field {
+	return ecs.Field{
+		K: "rpc.grpc.status_code",
+		V: int(code),
+	}
+}
+
+// methodFields splits the full method name "/package.service/method" into the rpc fields.
+func methodFields(fullMethod string) []interface{} {
+	name := strings.TrimPrefix(fullMethod, "/")
+	service, method := "", name
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		service, method = name[:i], name[i+1:]
+	}
+
+	return []interface{}{rpcSystem(), rpcService(service), rpcMethod(method)}
+}
+
+// outcome maps the status code to the event.outcome and the level. Only codes which indicate a server side
+// problem are logged as error.
+func outcome(code codes.Code) []interface{} {
+	res := []interface{}{rpcStatusCode(code)}
+
+	switch code {
+	case codes.OK:
+		return append(res, ecs.EventOutcome(ecs.EventOutcomeSuccess), ecs.Info())
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable,
+		codes.DataLoss:
+		return append(res, ecs.EventOutcome(ecs.EventOutcomeFailure), ecs.Error())
+	default:
+		return append(res, ecs.EventOutcome(ecs.EventOutcomeFailure), ecs.Warn())
+	}
+}
+
+// peerAddress returns the remote address of the connection or the empty string.
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+
+	return ""
+}
+
+// traceFields inspects the W3C traceparent and the request id from the given metadata.
+func traceFields(md metadata.MD) []interface{} {
+	var res []interface{}
+
+	if values := md.Get(traceParentKey); len(values) > 0 {
+		// format is version-traceid-parentid-flags. The parent id is the span of the caller and not ours, so
+		// only the trace id is logged.
+		parts := strings.Split(values[0], "-")
+		if len(parts) == 4 && len(parts[1]) == 32 && len(parts[2]) == 16 {
+			res = append(res, ecs.TraceID(parts[1]))
+		}
+	}
+
+	if values := md.Get(requestIDKey); len(values) > 0 {
+		res = append(res, ecs.HTTPRequestID(values[0]))
+	}
+
+	return res
+}
@@ -0,0 +1,283 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpclog
+
+import (
+	"context"
+	"github.com/golangee/log"
+	"github.com/golangee/log/field"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recorder struct {
+	mutex  sync.Mutex
+	events [][]field.DefaultField
+}
+
+func (r *recorder) Println(fields ...interface{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.events = append(r.events, field.Fields(fields...))
+}
+
+func (r *recorder) find(key string, val interface{}) []field.DefaultField {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, event := range r.events {
+		for _, f := range event {
+			if f.K == key && f.V == val {
+				return event
+			}
+		}
+	}
+
+	return nil
+}
+
+func value(event []field.DefaultField, key string) interface{} {
+	for _, f := range event {
+		if f.K == key {
+			return f.V
+		}
+	}
+
+	return nil
+}
+
+// uploadService is a client streaming service, which answers with a single response after the client has
+// closed its side of the stream.
+//
+//nolint:gochecknoglobals
+var uploadService = grpc.ServiceDesc{
+	ServiceName: "test.Upload",
+	HandlerType: (*interface{})(nil),
+	Streams: []grpc.StreamDesc{{
+		StreamName:    "Upload",
+		ClientStreams: true,
+		Handler: func(_ interface{}, stream grpc.ServerStream) error {
+			for {
+				err := stream.RecvMsg(&grpc_health_v1.HealthCheckRequest{})
+				if err == io.EOF {
+					return stream.SendMsg(&grpc_health_v1.HealthCheckResponse{})
+				}
+
+				if err != nil {
+					return err
+				}
+			}
+		},
+	}},
+}
+
+func dial(t *testing.T, serverLogger log.Logger) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryServerInterceptor(Options{Logger: serverLogger})),
+		grpc.StreamInterceptor(StreamServerInterceptor(Options{Logger: serverLogger, LogMessages: true})),
+	)
+	grpc_health_v1.RegisterHealthServer(srv, health.NewServer())
+	srv.RegisterService(&uploadService, struct{}{})
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor(Options{})),
+		grpc.WithStreamInterceptor(StreamClientInterceptor(Options{})),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn
+}
+
+func TestUnary(t *testing.T) {
+	srvRec := &recorder{}
+	cliRec := &recorder{}
+	conn := dial(t, srvRec)
+
+	ctx := log.WithLogger(context.Background(), cliRec)
+	ctx = metadata.AppendToOutgoingContext(ctx, traceParentKey,
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	client := grpc_health_v1.NewHealthClient(conn)
+	if _, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Check(ctx, &grpc_health_v1.HealthCheckRequest{Service: "unknown"}); err == nil {
+		t.Fatal("expected NotFound")
+	}
+
+	event := srvRec.find("event.outcome", "success")
+	if event == nil {
+		t.Fatal("expected success event")
+	}
+
+	expected := map[string]interface{}{
+		"rpc.system":  "grpc",
+		"rpc.service": "grpc.health.v1.Health",
+		"rpc.method":  "Check",
+		"trace.id":    "4bf92f3577b34da6a3ce929d0e0e4736",
+		"log.level":   "info",
+	}
+
+	for k, v := range expected {
+		if actual := value(event, k); actual != v {
+			t.Fatalf("expected %s=%v but got %v", k, v, actual)
+		}
+	}
+
+	if v := value(event, "span.id"); v != nil {
+		t.Fatalf("the parent id of the traceparent must not be logged as span.id: %v", v)
+	}
+
+	if value(event, "client.address") == nil {
+		t.Fatal("expected client.address")
+	}
+
+	if event := srvRec.find("rpc.grpc.status_code", 5); value(event, "event.outcome") != "failure" {
+		t.Fatalf("expected NotFound failure but got %v", event)
+	}
+
+	if event := cliRec.find("event.outcome", "success"); value(event, "destination.address") != "passthrough:///bufnet" {
+		t.Fatalf("expected client event but got %v", event)
+	}
+}
+
+func TestStream(t *testing.T) {
+	srvRec := &recorder{}
+	cliRec := &recorder{}
+	conn := dial(t, srvRec)
+
+	ctx, cancel := context.WithCancel(log.WithLogger(context.Background(), cliRec))
+	stream, err := grpc_health_v1.NewHealthClient(conn).Watch(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+
+	cancel()
+
+	if _, err := stream.Recv(); err == nil {
+		t.Fatal("expected canceled stream")
+	}
+
+	if event := cliRec.find("rpc.method", "Watch"); value(event, "rpc.grpc.status_code") != 1 {
+		t.Fatalf("expected canceled client event but got %v", event)
+	}
+
+	if event := srvRec.find("message", "message sent"); event == nil {
+		t.Fatal("expected message event")
+	}
+}
+
+func TestClientStream(t *testing.T) {
+	cliRec := &recorder{}
+	conn := dial(t, &recorder{})
+
+	ctx := log.WithLogger(context.Background(), cliRec)
+	desc := &uploadService.Streams[0]
+	stream, err := conn.NewStream(ctx, desc, "/test.Upload/Upload")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := stream.SendMsg(&grpc_health_v1.HealthCheckRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// like the generated CloseAndRecv, which never sees io.EOF
+	if err := stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := stream.RecvMsg(&grpc_health_v1.HealthCheckResponse{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if event := cliRec.find("rpc.method", "Upload"); value(event, "event.outcome") != "success" {
+		t.Fatalf("expected success client event but got %v", event)
+	}
+}
+
+func TestClientStreamCanceled(t *testing.T) {
+	cliRec := &recorder{}
+	conn := dial(t, &recorder{})
+
+	ctx, cancel := context.WithCancel(log.WithLogger(context.Background(), cliRec))
+	desc := &uploadService.Streams[0]
+	stream, err := conn.NewStream(ctx, desc, "/test.Upload/Upload")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := stream.SendMsg(&grpc_health_v1.HealthCheckRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the caller gives up mid-stream and never receives again
+	cancel()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for cliRec.find("rpc.method", "Upload") == nil && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if event := cliRec.find("rpc.method", "Upload"); value(event, "rpc.grpc.status_code") != int(codes.Canceled) {
+		t.Fatalf("expected canceled client event but got %v", event)
+	}
+
+	if err := stream.RecvMsg(&grpc_health_v1.HealthCheckResponse{}); err == nil {
+		t.Fatal("expected canceled stream")
+	}
+
+	cliRec.mutex.Lock()
+	defer cliRec.mutex.Unlock()
+
+	if len(cliRec.events) != 1 {
+		t.Fatalf("expected exactly one client event but got %d", len(cliRec.events))
+	}
+}
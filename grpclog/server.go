@@ -0,0 +1,143 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpclog
+
+import (
+	"context"
+	"github.com/golangee/log"
+	"github.com/golangee/log/ecs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const (
+	traceParentKey = "traceparent"
+	requestIDKey   = "x-request-id"
+)
+
+// Options configure the interceptors.
+type Options struct {
+	// Logger is the parent logger for all request scoped loggers of the server interceptors. If nil,
+	// log.NewLogger is used. The client interceptors always use log.FromContext.
+	Logger log.Logger
+	// LogMessages enables a debug event for each message sent or received on a stream.
+	LogMessages bool
+}
+
+func (o Options) parent() log.Logger {
+	if o.Logger == nil {
+		return log.NewLogger()
+	}
+
+	return o.Logger
+}
+
+// scope creates the request scoped logger containing the rpc, peer and trace fields and puts it into the context.
+func (o Options) scope(ctx context.Context, fullMethod string) (context.Context, log.Logger) {
+	fields := methodFields(fullMethod)
+	if adr := peerAddress(ctx); adr != "" {
+		fields = append(fields, ecs.ClientAddress(adr))
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		fields = append(fields, traceFields(md)...)
+	}
+
+	logger := log.WithFields(o.parent(), fields...)
+
+	return log.WithLogger(ctx, logger), logger
+}
+
+// UnaryServerInterceptor logs one event per unary RPC and provides a request scoped logger through the context.
+func UnaryServerInterceptor(opts Options) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		ctx, logger := opts.scope(ctx, info.FullMethod)
+
+		res, err := handler(ctx, req)
+		logger.Println(finish(start, err)...)
+
+		return res, err
+	}
+}
+
+// StreamServerInterceptor logs one event per streaming RPC and provides a request scoped logger through the
+// context. If enabled, each message is logged as well.
+func StreamServerInterceptor(opts Options) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx, logger := opts.scope(ss.Context(), info.FullMethod)
+
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx, logger: logger, logMessages: opts.LogMessages})
+		logger.Println(finish(start, err)...)
+
+		return err
+	}
+}
+
+// finish creates the fields for the final event.
+func finish(start time.Time, err error) []interface{} {
+	fields := []interface{}{ecs.EventDuration(time.Since(start))}
+	fields = append(fields, outcome(status.Code(err))...)
+
+	if err != nil {
+		fields = append(fields, err)
+	}
+
+	return fields
+}
+
+// serverStream replaces the context and optionally logs each message.
+type serverStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	logger      log.Logger
+	logMessages bool
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if s.logMessages {
+		logMessage(s.logger, "sent", err)
+	}
+
+	return err
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if s.logMessages {
+		logMessage(s.logger, "received", err)
+	}
+
+	return err
+}
+
+// logMessage emits a debug event for a single stream message.
+func logMessage(logger log.Logger, direction string, err error) {
+	fields := []interface{}{ecs.Debug(), ecs.Msg("message " + direction)}
+	if err != nil {
+		fields = append(fields, err)
+	}
+
+	logger.Println(fields...)
+}
@@ -0,0 +1,116 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package publicsuffix splits domains using the embedded public suffix list of https://publicsuffix.org, to
+// derive the ECS registered_domain, subdomain and top_level_domain fields.
+package publicsuffix
+
+import (
+	_ "embed" // for the public suffix list
+	"strings"
+	"sync"
+)
+
+// rule kinds of a suffix, which may be combined.
+const (
+	normal    = 1 << iota // com.ac
+	wildcard              // *.ck is stored as ck
+	exception             // !www.ck is stored as www.ck
+)
+
+//go:embed public_suffix_list.dat
+var list string //nolint:gochecknoglobals
+
+//nolint:gochecknoglobals
+var (
+	loadOnce sync.Once
+	rules    map[string]int
+)
+
+// PublicSuffix returns the effective top level domain, like co.uk for www.example.co.uk. Domains which are not
+// covered by any rule take their last label as suffix.
+func PublicSuffix(domain string) string {
+	_, _, suffix := Split(domain)
+
+	return suffix
+}
+
+// Split returns the subdomain, the registered domain and the public suffix of the domain, e.g. www,
+// example.co.uk and co.uk for www.example.co.uk. The domain is lower cased and a trailing dot is ignored.
+// The registered and subdomain are empty, if the domain is a public suffix itself.
+func Split(domain string) (subdomain, registered, suffix string) {
+	loadOnce.Do(load)
+
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if domain == "" {
+		return "", "", ""
+	}
+
+	labels := strings.Split(domain, ".")
+	n := len(labels) - 1 // the default rule * matches the last label
+
+	for i := range labels {
+		candidate := strings.Join(labels[i:], ".")
+		kind := rules[candidate]
+
+		if kind&exception != 0 {
+			n = i + 1
+
+			break
+		}
+
+		if kind&normal != 0 {
+			n = i
+
+			break
+		}
+
+		if kind&wildcard != 0 && i > 0 {
+			n = i - 1
+
+			break
+		}
+	}
+
+	suffix = strings.Join(labels[n:], ".")
+	if n == 0 {
+		return "", "", suffix
+	}
+
+	registered = strings.Join(labels[n-1:], ".")
+	subdomain = strings.Join(labels[:n-1], ".")
+
+	return subdomain, registered, suffix
+}
+
+// load parses the embedded list. Comments and empty lines are ignored.
+func load() {
+	rules = make(map[string]int, strings.Count(list, "\n"))
+
+	for _, line := range strings.Split(list, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "!"):
+			rules[line[1:]] |= exception
+		case strings.HasPrefix(line, "*."):
+			rules[line[2:]] |= wildcard
+		default:
+			rules[line] |= normal
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publicsuffix
+
+import "testing"
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		domain, sub, registered, suffix string
+	}{
+		{"www.example.co.uk", "www", "example.co.uk", "co.uk"},
+		{"Example.COM.", "", "example.com", "com"},
+		{"a.b.example.com", "a.b", "example.com", "com"},
+		{"co.uk", "", "", "co.uk"},
+		{"foo.bar.ck", "", "foo.bar.ck", "bar.ck"},
+		{"www.ck", "", "www.ck", "ck"},
+		{"host.unknowntld", "", "host.unknowntld", "unknowntld"},
+		{"user.github.io", "", "user.github.io", "github.io"},
+	}
+
+	for _, tt := range tests {
+		sub, registered, suffix := Split(tt.domain)
+		if sub != tt.sub || registered != tt.registered || suffix != tt.suffix {
+			t.Errorf("%s: expected %q %q %q but got %q %q %q", tt.domain, tt.sub, tt.registered, tt.suffix, sub,
+				registered, suffix)
+		}
+	}
+}
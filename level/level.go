@@ -0,0 +1,139 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package level
+
+import (
+	"fmt"
+	"github.com/golangee/log/field"
+	"strings"
+)
+
+// Key is the ECS key of the level field.
+const Key = "log.level"
+
+// Level is an ordered representation of the log.level values.
+type Level int
+
+// The known levels in ascending order.
+const (
+	Trace Level = iota
+	Debug
+	Info
+	Warn
+	Error
+	Fatal
+	Panic
+)
+
+//nolint:gochecknoglobals
+var names = []string{"trace", "debug", "info", "warn", "error", "fatal", "panic"}
+
+// String returns the ECS value, like "info".
+func (l Level) String() string {
+	if l < Trace || l > Panic {
+		return fmt.Sprintf("level(%d)", int(l))
+	}
+
+	return names[l]
+}
+
+// MarshalText returns the String.
+func (l Level) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText parses the text using Parse.
+func (l *Level) UnmarshalText(text []byte) error {
+	v, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*l = v
+
+	return nil
+}
+
+// Parse returns the level for the given case insensitive name. "warning" is accepted as an alias.
+func Parse(s string) (Level, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "warning" {
+		return Warn, nil
+	}
+
+	for i, name := range names {
+		if name == s {
+			return Level(i), nil
+		}
+	}
+
+	return Info, fmt.Errorf("unknown log level '%s', expected one of %s", s, strings.Join(names, ", "))
+}
+
+// Find inspects the unresolved fields (as passed to a logger function) for the last log.level and returns it.
+// Only explicit fields and the auto-detected level strings (see field.Fields) are considered. Events without
+// a level are info.
+func Find(v ...interface{}) Level {
+	res := Info
+	for _, f := range v {
+		var name string
+
+		switch t := f.(type) {
+		case field.DefaultField:
+			if t.K != Key {
+				continue
+			}
+
+			name, _ = t.V.(string)
+		case *field.DefaultField:
+			if t.K != Key {
+				continue
+			}
+
+			name, _ = t.V.(string)
+		case field.Field:
+			if t.Key() != Key {
+				continue
+			}
+
+			name, _ = t.Value().(string)
+		case string:
+			// the same strings as auto-detected by field.Fields
+			switch t {
+			case "trace", "debug", "info", "warn", "fatal":
+				name = t
+			default:
+				continue
+			}
+		default:
+			continue
+		}
+
+		if l, err := Parse(name); err == nil {
+			res = l
+		}
+	}
+
+	return res
+}
+
+// Filter returns a new logger function which only forwards events whose level is at least min.
+func Filter(next func(fields ...interface{}), min Level) func(fields ...interface{}) {
+	return func(fields ...interface{}) {
+		if Find(fields...) >= min {
+			next(fields...)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package level
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Wildcard matches one or more entire segments of a logger name.
+const Wildcard = "*"
+
+// ParseRules parses a comma separated list of pattern=level rules, like "info,my.*=debug,*.http=warn". An entry
+// without a pattern defines the root threshold and is returned as rule with the empty prefix. A pattern consists of
+// dotted segments, where a segment is either a literal or the Wildcard, which matches one or more entire
+// segments. Like any prefix, a pattern also matches all children of a matching name.
+func ParseRules(s string) ([]Rule, error) {
+	var res []Rule
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		pattern, lvl := "", entry
+		if i := strings.LastIndexByte(entry, '='); i >= 0 {
+			pattern, lvl = strings.TrimSpace(entry[:i]), entry[i+1:]
+			if err := ValidatePattern(pattern); err != nil {
+				return nil, err
+			}
+		}
+
+		l, err := Parse(lvl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule '%s': %w", entry, err)
+		}
+
+		res = append(res, Rule{Prefix: pattern, Level: l})
+	}
+
+	return res, nil
+}
+
+// ValidatePattern checks that each segment is either a non-empty literal or the Wildcard.
+func ValidatePattern(pattern string) error {
+	if pattern == "" {
+		return nil
+	}
+
+	for _, segment := range strings.Split(pattern, ".") {
+		if segment == "" {
+			return fmt.Errorf("invalid pattern '%s': empty segment", pattern)
+		}
+
+		if segment != Wildcard && strings.Contains(segment, Wildcard) {
+			return fmt.Errorf("invalid pattern '%s': a wildcard must match entire segments", pattern)
+		}
+	}
+
+	return nil
+}
+
+// specificity orders matching rules: more literal segments win and then fewer wildcards.
+type specificity struct {
+	literals  int
+	wildcards int
+}
+
+func (a specificity) moreThan(b specificity) bool {
+	if a.literals != b.literals {
+		return a.literals > b.literals
+	}
+
+	return a.wildcards < b.wildcards
+}
+
+func specificityOf(pattern []string) specificity {
+	var res specificity
+	for _, segment := range pattern {
+		if segment == Wildcard {
+			res.wildcards++
+		} else {
+			res.literals++
+		}
+	}
+
+	return res
+}
+
+// split returns the dotted segments or nil for the root.
+func split(name string) []string {
+	if name == "" {
+		return nil
+	}
+
+	return strings.Split(name, ".")
+}
+
+// match returns true, if the pattern matches the name or one of its parents.
+func match(pattern, name []string) bool {
+	if len(pattern) == 0 {
+		return true
+	}
+
+	if len(name) == 0 {
+		return false
+	}
+
+	if pattern[0] != Wildcard {
+		return pattern[0] == name[0] && match(pattern[1:], name[1:])
+	}
+
+	for i := 1; i <= len(name); i++ {
+		if match(pattern[1:], name[i:]) {
+			return true
+		}
+	}
+
+	return false
+}
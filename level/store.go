@@ -0,0 +1,322 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package level
+
+import (
+	"github.com/golangee/log/field"
+	"sort"
+	"sync"
+	"time"
+)
+
+// LoggerKey is the ECS key of the logger name.
+const LoggerKey = "log.logger"
+
+// maxKnown limits the amount of remembered logger names and cached thresholds. If the limit is reached, the
+// names are cleared and refilled by the subsequent events, so that a lookup is not always slow afterwards.
+const maxKnown = 1024
+
+// Default is the store which is consulted by the default logger and the pipeline package.
+//
+//nolint:gochecknoglobals
+var Default = NewStore(Trace)
+
+// Rule is a threshold for a logger name prefix.
+type Rule struct {
+	// Prefix is a dotted logger name, which matches itself and all children. The empty prefix matches
+	// everything. It may contain wildcards, see ParseRules.
+	Prefix string `json:"prefix"`
+	// Level is the threshold.
+	Level Level `json:"level"`
+	// Expires is the zero time or the point in time, when the rule is removed automatically.
+	Expires time.Time `json:"expires,omitempty"`
+}
+
+func (r Rule) expired(now time.Time) bool {
+	return !r.Expires.IsZero() && !now.Before(r.Expires)
+}
+
+// Store contains the thresholds for the dotted logger names (see ecs.Log) which can be changed at runtime. The
+// threshold of a name is inherited from the most specific matching rule, where a prefix only matches entire
+// name segments, so that "my.service" matches "my.service.db" but not "my.services". So a threshold set for
+// "my.service" is inherited by "my.service.db" and can be overridden for "my.service.db.pool". The effective
+// threshold is cached per name and the cache is invalidated on any change or rule expiry.
+type Store struct {
+	mutex      sync.RWMutex
+	root       Level
+	rules      map[string]compiledRule
+	known      map[string]struct{}
+	cache      map[string]Level
+	nextExpiry time.Time
+	now        func() time.Time
+}
+
+// compiledRule keeps the split pattern.
+type compiledRule struct {
+	Rule
+	pattern     []string
+	specificity specificity
+}
+
+// NewStore creates a store with the given root threshold.
+func NewStore(root Level) *Store {
+	return &Store{
+		root:  root,
+		rules: map[string]compiledRule{},
+		known: map[string]struct{}{},
+		cache: map[string]Level{},
+		now:   time.Now,
+	}
+}
+
+// SetRoot changes the threshold for names without any matching rule.
+func (s *Store) SetRoot(l Level) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.root = l
+	s.invalidate()
+}
+
+// Root returns the threshold for names without any matching rule.
+func (s *Store) Root() Level {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.root
+}
+
+// Set defines the threshold for the prefix and all its children. If ttl is greater than zero, the rule expires
+// automatically after that duration, which is useful to raise the verbosity temporarily. An invalid pattern
+// is ignored, see ValidatePattern.
+func (s *Store) Set(prefix string, l Level, ttl time.Duration) {
+	r := Rule{Prefix: prefix, Level: l}
+	if ttl > 0 {
+		r.Expires = s.now().Add(ttl)
+	}
+
+	s.SetRules(r)
+}
+
+// SetRules defines or replaces the given rules, e.g. as returned by ParseRules. Rules with an invalid pattern
+// are ignored, see ValidatePattern.
+func (s *Store) SetRules(rules ...Rule) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.add(rules)
+	s.invalidate()
+}
+
+// ReplaceRules removes all rules and defines the given ones instead, see also SetRules.
+func (s *Store) ReplaceRules(rules ...Rule) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.rules = map[string]compiledRule{}
+	s.add(rules)
+	s.invalidate()
+}
+
+// add compiles the valid rules. The lock must be held.
+func (s *Store) add(rules []Rule) {
+	for _, r := range rules {
+		if ValidatePattern(r.Prefix) != nil {
+			continue
+		}
+
+		pattern := split(r.Prefix)
+		s.rules[r.Prefix] = compiledRule{Rule: r, pattern: pattern, specificity: specificityOf(pattern)}
+	}
+}
+
+// Reset removes the rule of the prefix, so that the threshold is inherited again.
+func (s *Store) Reset(prefix string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.rules, prefix)
+	s.invalidate()
+}
+
+// invalidate clears the cache and determines the next expiry. The lock must be held.
+func (s *Store) invalidate() {
+	s.cache = map[string]Level{}
+	s.nextExpiry = time.Time{}
+
+	for _, r := range s.rules {
+		if !r.Expires.IsZero() && (s.nextExpiry.IsZero() || r.Expires.Before(s.nextExpiry)) {
+			s.nextExpiry = r.Expires
+		}
+	}
+}
+
+// Rules returns all rules, which have not been expired, sorted by prefix.
+func (s *Store) Rules() []Rule {
+	now := s.now()
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	res := make([]Rule, 0, len(s.rules))
+	for _, r := range s.rules {
+		if !r.expired(now) {
+			res = append(res, r.Rule)
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Prefix < res[j].Prefix
+	})
+
+	return res
+}
+
+// Effective returns the threshold of the given logger name.
+func (s *Store) Effective(name string) Level {
+	now := s.now()
+
+	s.mutex.RLock()
+	l, ok := s.cache[name]
+	valid := s.nextExpiry.IsZero() || now.Before(s.nextExpiry)
+	s.mutex.RUnlock()
+
+	if ok && valid {
+		return l
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.nextExpiry.IsZero() && !now.Before(s.nextExpiry) {
+		for prefix, r := range s.rules {
+			if r.expired(now) {
+				delete(s.rules, prefix)
+			}
+		}
+
+		s.invalidate()
+	}
+
+	l = s.lookup(name)
+	if len(s.cache) >= maxKnown {
+		s.cache = map[string]Level{}
+	}
+
+	s.cache[name] = l
+
+	return l
+}
+
+// lookup finds the most specific rule or returns the root. The lock must be held.
+func (s *Store) lookup(name string) Level {
+	segments := split(name)
+	found := false
+	best := compiledRule{}
+
+	for _, r := range s.rules {
+		if !match(r.pattern, segments) {
+			continue
+		}
+
+		if !found || r.specificity.moreThan(best.specificity) ||
+			(r.specificity == best.specificity && r.Prefix < best.Prefix) {
+			best = r
+			found = true
+		}
+	}
+
+	if !found {
+		return s.root
+	}
+
+	return best.Level
+}
+
+// Enabled returns true, if an event with the given level and logger name passes the threshold. The name is
+// remembered, see Known.
+func (s *Store) Enabled(name string, l Level) bool {
+	s.remember(name)
+
+	return l >= s.Effective(name)
+}
+
+// Known returns the sorted logger names, which have been seen by Enabled. If there are more than maxKnown
+// names, only the recently seen ones are returned.
+func (s *Store) Known() []string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	res := make([]string, 0, len(s.known))
+	for name := range s.known {
+		res = append(res, name)
+	}
+
+	sort.Strings(res)
+
+	return res
+}
+
+// Filter returns a new logger function which only forwards events passing the threshold of their logger name.
+func (s *Store) Filter(next func(fields ...interface{})) func(fields ...interface{}) {
+	return func(fields ...interface{}) {
+		if s.Enabled(Name(fields...), Find(fields...)) {
+			next(fields...)
+		}
+	}
+}
+
+func (s *Store) remember(name string) {
+	s.mutex.RLock()
+	_, ok := s.known[name]
+	s.mutex.RUnlock()
+
+	if ok || name == "" {
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.known) >= maxKnown {
+		s.known = map[string]struct{}{}
+	}
+
+	s.known[name] = struct{}{}
+}
+
+// Name inspects the unresolved fields (as passed to a logger function) for the last log.logger and returns it.
+// Returns the empty string, if no logger name is defined.
+func Name(v ...interface{}) string {
+	name := ""
+	for _, f := range v {
+		switch t := f.(type) {
+		case field.DefaultField:
+			if t.K == LoggerKey {
+				name, _ = t.V.(string)
+			}
+		case *field.DefaultField:
+			if t.K == LoggerKey {
+				name, _ = t.V.(string)
+			}
+		case field.Field:
+			if t.Key() == LoggerKey {
+				name, _ = t.Value().(string)
+			}
+		}
+	}
+
+	return name
+}
@@ -0,0 +1,104 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package level
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestStore(t *testing.T) {
+	now := time.Now()
+	s := NewStore(Info)
+	s.now = func() time.Time {
+		return now
+	}
+
+	rules, err := ParseRules("warn, my.service=debug, my.service.db.pool=error, *.http=trace")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.SetRules(rules...)
+
+	expected := map[string]Level{
+		"":                        Warn,
+		"other":                   Warn,
+		"my.services":             Warn,
+		"my.service":              Debug,
+		"my.service.db":           Debug,
+		"my.service.db.pool":      Error,
+		"my.service.db.pool.conn": Error,
+		"a.http":                  Trace,
+		"a.b.http.client":         Trace,
+		"my.service.http":         Debug, // more literal segments win
+	}
+
+	for name, l := range expected {
+		if actual := s.Effective(name); actual != l {
+			t.Fatalf("expected %s=%v but got %v", name, l, actual)
+		}
+	}
+
+	s.Set("my.service.db", Panic, time.Minute)
+	if s.Effective("my.service.db") != Panic {
+		t.Fatal("expected cache invalidation")
+	}
+
+	now = now.Add(time.Hour)
+	if s.Effective("my.service.db") != Debug {
+		t.Fatal("expected rule expiry")
+	}
+
+	if _, err := ParseRules("my.ser*=debug"); err == nil {
+		t.Fatal("expected invalid pattern")
+	}
+}
+
+func TestStoreRefill(t *testing.T) {
+	s := NewStore(Info)
+
+	for i := 0; i <= maxKnown; i++ {
+		s.Enabled("my.logger."+strconv.Itoa(i), Info)
+	}
+
+	last := "my.logger." + strconv.Itoa(maxKnown)
+	if _, ok := s.cache[last]; !ok {
+		t.Fatal("expected the cache to be refilled")
+	}
+
+	if _, ok := s.known[last]; !ok {
+		t.Fatal("expected the known names to be refilled")
+	}
+
+	if len(s.cache) > maxKnown || len(s.known) > maxKnown {
+		t.Fatalf("expected at most %d entries but got %d and %d", maxKnown, len(s.cache), len(s.known))
+	}
+}
+
+func TestStoreReplaceRules(t *testing.T) {
+	s := NewStore(Info)
+	s.SetRules(Rule{Prefix: "my.service", Level: Debug}, Rule{Prefix: "my.other", Level: Error})
+	s.ReplaceRules(Rule{Prefix: "my.other", Level: Warn})
+
+	if l := s.Effective("my.service"); l != Info {
+		t.Fatalf("expected the stale rule to be removed but got %v", l)
+	}
+
+	if l := s.Effective("my.other"); l != Warn {
+		t.Fatalf("expected the replaced rule but got %v", l)
+	}
+}
@@ -0,0 +1,435 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/token"
+	"go/types"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"strconv"
+)
+
+const (
+	logPkg   = "github.com/golangee/log"
+	fieldPkg = "github.com/golangee/log/field"
+	ecsPkg   = "github.com/golangee/log/ecs"
+)
+
+// Analyzer checks the usage of github.com/golangee/log.
+//
+//nolint:gochecknoglobals
+var Analyzer = &analysis.Analyzer{
+	Name:      "golangeelog",
+	Doc:       "check usage of github.com/golangee/log: debug guards, duplicate keys, ErrStack in loops, ECS keys",
+	Run:       run,
+	Requires:  []*analysis.Analyzer{inspect.Analyzer},
+	FactTypes: []analysis.Fact{new(fieldKey)},
+}
+
+// fieldKey is exported for functions, which return a field with a constant key, like ecs.Msg.
+type fieldKey struct {
+	Key string
+}
+
+// AFact marks fieldKey as a fact.
+func (*fieldKey) AFact() {}
+
+func (f *fieldKey) String() string {
+	return "fieldKey(" + f.Key + ")"
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	exportFieldKeys(pass, insp)
+
+	insp.WithStack([]ast.Node{(*ast.CallExpr)(nil), (*ast.CompositeLit)(nil)}, func(n ast.Node, push bool,
+		stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		switch t := n.(type) {
+		case *ast.CallExpr:
+			checkCall(pass, t, stack)
+		case *ast.CompositeLit:
+			if key, expr, ok := literalKey(pass, t); ok {
+				checkECSKey(pass, key, expr)
+			}
+		}
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// exportFieldKeys finds functions, which return a field with a single constant key.
+func exportFieldKeys(pass *analysis.Pass, insp *inspector.Inspector) {
+	insp.Preorder([]ast.Node{(*ast.FuncDecl)(nil)}, func(n ast.Node) {
+		decl := n.(*ast.FuncDecl)
+		if decl.Body == nil || decl.Recv != nil {
+			return
+		}
+
+		obj, ok := pass.TypesInfo.Defs[decl.Name].(*types.Func)
+		if !ok {
+			return
+		}
+
+		results := obj.Type().(*types.Signature).Results()
+		if results.Len() != 1 || !isField(results.At(0).Type()) {
+			return
+		}
+
+		keys := map[string]bool{}
+		ast.Inspect(decl.Body, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.CompositeLit); ok {
+				if key, _, ok := literalKey(pass, lit); ok {
+					keys[key] = true
+				}
+			}
+
+			return true
+		})
+
+		if len(keys) == 1 {
+			for key := range keys {
+				pass.ExportObjectFact(obj, &fieldKey{Key: key})
+			}
+		}
+	})
+}
+
+// checkCall inspects logger calls and ecs helper calls.
+func checkCall(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node) {
+	fn := callee(pass, call)
+
+	if fn != nil && fn.Pkg() != nil && fn.Pkg().Path() == ecsPkg {
+		switch fn.Name() {
+		case "Trace", "Debug":
+			if !guarded(pass, stack) {
+				reportUnguarded(pass, call, stack, "ecs."+fn.Name()+"()")
+			}
+		case "ErrStack", "ErrStackFrames":
+			if inLoop(stack) {
+				pass.Reportf(call.Pos(), "ecs.%s captures the stack trace, which is expensive within a loop",
+					fn.Name())
+			}
+		}
+	}
+
+	if fn != nil && fn.Pkg() != nil && fn.Pkg().Path() == logPkg && fn.Name() == "V" && len(call.Args) == 2 {
+		if key, ok := constString(pass, call.Args[0]); ok {
+			checkECSKey(pass, key, call.Args[0])
+		}
+	}
+
+	if !isLoggerCall(pass, call) {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, arg := range call.Args {
+		if s, ok := constString(pass, arg); ok && (s == "trace" || s == "debug") && !guarded(pass, stack) {
+			reportUnguarded(pass, call, stack, strconv.Quote(s))
+		}
+
+		key, ok := argKey(pass, arg)
+		if !ok {
+			continue
+		}
+
+		if seen[key] && key != "message" {
+			pass.Reportf(arg.Pos(), "duplicate field key %q, only the last one is kept", key)
+		}
+
+		seen[key] = true
+	}
+}
+
+// isLoggerCall returns true for calls of log.Println, any variadic Println(...interface{}) method and
+// log.LoggerFunc values.
+func isLoggerCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	if tv, ok := pass.TypesInfo.Types[call.Fun]; ok {
+		if named, ok := tv.Type.(*types.Named); ok && named.Obj().Pkg() != nil &&
+			named.Obj().Pkg().Path() == logPkg && named.Obj().Name() == "LoggerFunc" {
+			return true
+		}
+	}
+
+	fn := callee(pass, call)
+	if fn == nil || fn.Name() != "Println" {
+		return false
+	}
+
+	if fn.Pkg() != nil && fn.Pkg().Path() == logPkg {
+		return true
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() == nil || !sig.Variadic() || sig.Params().Len() != 1 {
+		return false
+	}
+
+	slice, ok := sig.Params().At(0).Type().(*types.Slice)
+	if !ok {
+		return false
+	}
+
+	iface, ok := slice.Elem().Underlying().(*types.Interface)
+
+	return ok && iface.Empty()
+}
+
+// argKey determines the constant field key of a logger argument.
+func argKey(pass *analysis.Pass, arg ast.Expr) (string, bool) {
+	switch t := ast.Unparen(arg).(type) {
+	case *ast.CompositeLit:
+		key, _, ok := literalKey(pass, t)
+		return key, ok
+	case *ast.UnaryExpr:
+		if lit, ok := t.X.(*ast.CompositeLit); ok && t.Op == token.AND {
+			key, _, ok := literalKey(pass, lit)
+			return key, ok
+		}
+	case *ast.CallExpr:
+		fn := callee(pass, t)
+		if fn == nil {
+			return "", false
+		}
+
+		if fn.Pkg() != nil && fn.Pkg().Path() == logPkg && fn.Name() == "V" && len(t.Args) == 2 {
+			return constString(pass, t.Args[0])
+		}
+
+		var fact fieldKey
+		if pass.ImportObjectFact(fn, &fact) {
+			return fact.Key, true
+		}
+	}
+
+	return "", false
+}
+
+// literalKey returns the constant K of a field.DefaultField (or ecs.Field) literal and its expression.
+func literalKey(pass *analysis.Pass, lit *ast.CompositeLit) (string, ast.Expr, bool) {
+	if tv, ok := pass.TypesInfo.Types[lit]; !ok || !isField(tv.Type) {
+		return "", nil, false
+	}
+
+	for i, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			if i == 0 {
+				s, ok := constString(pass, elt)
+				return s, elt, ok
+			}
+
+			continue
+		}
+
+		if id, ok := kv.Key.(*ast.Ident); ok && id.Name == "K" {
+			s, ok := constString(pass, kv.Value)
+			return s, kv.Value, ok
+		}
+	}
+
+	return "", nil, false
+}
+
+// checkECSKey reports custom keys and suggests a label instead. The fix is only offered for a string literal,
+// because a named constant or a concatenation cannot be replaced safely.
+func checkECSKey(pass *analysis.Pass, key string, expr ast.Expr) {
+	if isECS(key) {
+		return
+	}
+
+	label := labelKey(key)
+	diag := analysis.Diagnostic{
+		Pos:     expr.Pos(),
+		Message: fmt.Sprintf("%q is not an ECS field, use %q for custom keys", key, label),
+	}
+
+	if lit, ok := expr.(*ast.BasicLit); ok {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: "Use " + label,
+			TextEdits: []analysis.TextEdit{{
+				Pos:     lit.Pos(),
+				End:     lit.End(),
+				NewText: []byte(strconv.Quote(label)),
+			}},
+		}}
+	}
+
+	pass.Report(diag)
+}
+
+// reportUnguarded reports a trace or debug event and suggests to wrap the statement, if possible.
+func reportUnguarded(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node, what string) {
+	diag := analysis.Diagnostic{
+		Pos:     call.Pos(),
+		Message: what + " is not guarded by if log.Debug, so its arguments are evaluated even in production",
+	}
+
+	stmt := enclosingStmt(stack)
+	name := importName(pass, call.Pos(), logPkg)
+	if stmt != nil && name != "" {
+		var buf bytes.Buffer
+		if err := format.Node(&buf, pass.Fset, stmt); err == nil {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: "Guard with if " + name + ".Debug",
+				TextEdits: []analysis.TextEdit{{
+					Pos:     stmt.Pos(),
+					End:     stmt.End(),
+					NewText: []byte("if " + name + ".Debug {\n" + buf.String() + "\n}"),
+				}},
+			}}
+		}
+	}
+
+	pass.Report(diag)
+}
+
+// guarded returns true, if the node is within the body of an if statement, whose condition refers to log.Debug.
+func guarded(pass *analysis.Pass, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		ifStmt, ok := stack[i].(*ast.IfStmt)
+		if !ok || stack[i+1] != ifStmt.Body {
+			continue
+		}
+
+		if refersToDebug(pass, ifStmt.Cond) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// refersToDebug inspects the condition, which may combine log.Debug using &&.
+func refersToDebug(pass *analysis.Pass, cond ast.Expr) bool {
+	switch t := ast.Unparen(cond).(type) {
+	case *ast.BinaryExpr:
+		return t.Op == token.LAND && (refersToDebug(pass, t.X) || refersToDebug(pass, t.Y))
+	case *ast.SelectorExpr:
+		obj := pass.TypesInfo.Uses[t.Sel]
+		return obj != nil && obj.Pkg() != nil && obj.Pkg().Path() == logPkg && obj.Name() == "Debug"
+	case *ast.Ident:
+		obj := pass.TypesInfo.Uses[t]
+		return obj != nil && obj.Pkg() != nil && obj.Pkg().Path() == logPkg && obj.Name() == "Debug"
+	}
+
+	return false
+}
+
+// inLoop returns true, if the node is within a loop of the same function.
+func inLoop(stack []ast.Node) bool {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch stack[i].(type) {
+		case *ast.ForStmt, *ast.RangeStmt:
+			return true
+		case *ast.FuncLit, *ast.FuncDecl:
+			return false
+		}
+	}
+
+	return false
+}
+
+// enclosingStmt returns the innermost expression statement, if it is part of a block.
+func enclosingStmt(stack []ast.Node) ast.Stmt {
+	for i := len(stack) - 1; i > 0; i-- {
+		if stmt, ok := stack[i].(*ast.ExprStmt); ok {
+			if _, ok := stack[i-1].(*ast.BlockStmt); ok {
+				return stmt
+			}
+
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// importName returns the local name of the imported package in the file at pos or the empty string.
+func importName(pass *analysis.Pass, pos token.Pos, path string) string {
+	for _, file := range pass.Files {
+		if file.Pos() > pos || pos > file.End() {
+			continue
+		}
+
+		for _, spec := range file.Imports {
+			if p, _ := strconv.Unquote(spec.Path.Value); p == path {
+				if spec.Name != nil {
+					return spec.Name.Name
+				}
+
+				for _, imp := range pass.Pkg.Imports() {
+					if imp.Path() == path {
+						return imp.Name()
+					}
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// callee returns the called function or method or nil.
+func callee(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	var id *ast.Ident
+
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return nil
+	}
+
+	fn, _ := pass.TypesInfo.Uses[id].(*types.Func)
+
+	return fn
+}
+
+// isField returns true for field.DefaultField and therefore also for the alias ecs.Field.
+func isField(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	return named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == fieldPkg && named.Obj().Name() == "DefaultField"
+}
+
+// constString returns the value of a constant string expression.
+func constString(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(tv.Value), true
+}
@@ -0,0 +1,23 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lint provides a go/analysis Analyzer which understands the usage of github.com/golangee/log. It reports
+//   - trace or debug events which are not guarded by if log.Debug
+//   - duplicate field keys in a single logger call
+//   - ecs.ErrStack within loops
+//   - custom keys, which are neither ECS fields nor labels.*
+//
+// The Analyzer can be run using go vet -vettool=$(which loglint) or integrated into golangci-lint. It is a separate
+// module, to keep the core logging facade free of dependencies.
+package lint
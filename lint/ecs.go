@@ -0,0 +1,75 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	_ "embed" // for the field definitions
+	"strings"
+	"sync"
+)
+
+// fieldsTSV contains the ECS field definitions, one per line: the name, the type and the allowed values, delimited
+// by tabs. It is a copy of ecs/schema/fields.tsv, because this module does not depend on github.com/golangee/log.
+//
+//go:embed fields.tsv
+var fieldsTSV string //nolint:gochecknoglobals
+
+//nolint:gochecknoglobals
+var (
+	loadOnce sync.Once
+	// ecsFields maps the flat ECS field names to their type.
+	ecsFields map[string]string
+)
+
+func load() {
+	ecsFields = make(map[string]string, strings.Count(fieldsTSV, "\n"))
+
+	for _, line := range strings.Split(fieldsTSV, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		cols := strings.Split(line, "\t")
+		if len(cols) < 2 {
+			continue
+		}
+
+		ecsFields[cols[0]] = cols[1]
+	}
+}
+
+// isECS returns true, if the key is an ECS field or below an object, flattened or nested field, whose sub keys
+// are not defined by ECS, like labels.*.
+func isECS(key string) bool {
+	loadOnce.Do(load)
+
+	if _, ok := ecsFields[key]; ok {
+		return true
+	}
+
+	for i := strings.LastIndexByte(key, '.'); i > 0; i = strings.LastIndexByte(key[:i], '.') {
+		switch ecsFields[key[:i]] {
+		case "object", "flattened", "nested":
+			return true
+		}
+	}
+
+	return false
+}
+
+// labelKey converts a custom key into a valid ECS label key, which must not contain dots.
+func labelKey(key string) string {
+	return "labels." + strings.ReplaceAll(key, ".", "_")
+}
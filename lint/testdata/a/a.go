@@ -0,0 +1,66 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/golangee/log"
+	"github.com/golangee/log/ecs"
+)
+
+func guardedDebug(logger log.Logger) {
+	if log.Debug {
+		logger.Println(ecs.Debug(), ecs.Msg("fine"))
+	}
+
+	if log.Debug && true {
+		logger.Println("trace", "fine")
+	}
+}
+
+func unguardedDebug(logger log.Logger) {
+	logger.Println(ecs.Trace(), ecs.Msg("hello")) // want `ecs.Trace\(\) is not guarded by if log.Debug`
+	fmt.Println("debug")
+}
+
+func unguardedString() {
+	log.Println("debug", "hello") // want `"debug" is not guarded by if log.Debug`
+}
+
+func duplicates(logger log.Logger) {
+	logger.Println(ecs.Log("a"), ecs.Msg("x"), ecs.Log("b")) // want `duplicate field key "log.logger"`
+	logger.Println(ecs.Msg("x"), ecs.Msg("y"))
+	logger.Println(log.V("log.logger", "a"), ecs.Log("b")) // want `duplicate field key "log.logger"`
+}
+
+func loops(logger log.Logger) {
+	for i := 0; i < 3; i++ {
+		logger.Println(ecs.ErrStack()) // want `ecs.ErrStack captures the stack trace`
+	}
+
+	logger.Println(ecs.ErrStack())
+}
+
+func keys(logger log.Logger) {
+	logger.Println(log.V("my.key", 1)) // want `"my.key" is not an ECS field, use "labels.my_key" for custom keys`
+	logger.Println(log.V("labels.foo", 1), log.V("user.id", 1))
+	logger.Println(log.V(customKey, 1))     // want `"custom.key" is not an ECS field`
+	logger.Println(log.V("my."+"other", 1)) // want `"my.other" is not an ECS field`
+	logger.Println(log.V(`raw.key`, 1))     // want `"raw.key" is not an ECS field`
+}
+
+const customKey = "custom.key"
+
+// misspelled keys of valid field sets are custom keys as well.
+func misspelled(logger log.Logger) {
+	logger.Println(log.V("url.Path", 1))             // want `"url.Path" is not an ECS field`
+	logger.Println(log.V("error.mesage", 1))         // want `"error.mesage" is not an ECS field`
+	logger.Println(log.V("user.favourite_color", 1)) // want `"user.favourite_color" is not an ECS field`
+}
+
+func myField() ecs.Field { // want myField:`fieldKey\(user.name\)`
+	return ecs.Field{K: "user.name", V: "x"}
+}
+
+func customConstructor(logger log.Logger) {
+	logger.Println(myField(), ecs.Msg("x"), myField()) // want `duplicate field key "user.name"`
+}
@@ -0,0 +1,7 @@
+module example.com/testdata
+
+go 1.15
+
+require github.com/golangee/log v0.0.0
+
+replace github.com/golangee/log => ../../
@@ -17,7 +17,6 @@ package log
 import (
 	"github.com/golangee/log/ecs"
 	"github.com/golangee/log/field"
-	"github.com/golangee/log/simple"
 	log2 "log"
 )
 
@@ -62,12 +61,18 @@ var defaultFunc LoggerFunc
 
 func init() {
 	log2.SetFlags(0)
-	if IsDevelopment() {
-		defaultFunc = ecs.WithTime(simple.PrintColored)
-	} else {
-		defaultFunc = ecs.WithTime(simple.PrintStructured)
+
+	cfg, err := ConfigFromEnv()
+	if applyErr := cfg.Apply(); applyErr != nil {
+		// only the output may fail
+		cfg.Output = OutputStderr
+		_ = cfg.Apply()
+		err = applyErr
 	}
 
+	if err != nil {
+		Println(ecs.Error(), ecs.Msg("invalid logging configuration"), err)
+	}
 }
 
 // SetDefault just sets a delegate for NewLogger.
@@ -20,7 +20,10 @@ import (
 	"fmt"
 	"github.com/golangee/log"
 	"github.com/golangee/log/ecs"
+	"github.com/golangee/log/level"
 	"github.com/golangee/log/simple"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 )
 
@@ -56,3 +59,176 @@ func logSomeStuff(logger log.Logger) {
 	logger.Println("info", "auto message", "https://automatic.url", fmt.Errorf("automatic error"))
 	fmt.Print("\n\n---\n\n")
 }
+
+func TestRecover(t *testing.T) {
+	var fields []interface{}
+	ctx := log.WithLogger(context.Background(), log.LoggerFunc(func(f ...interface{}) {
+		fields = f
+	}))
+
+	done := make(chan interface{})
+	log.PanicPolicy{Hook: func(ctx context.Context, v interface{}) {
+		done <- v
+	}}.Go(ctx, func(ctx context.Context) {
+		panic("oops")
+	})
+
+	if v := <-done; v != "oops" {
+		t.Fatalf("expected oops but got %v", v)
+	}
+
+	if len(fields) == 0 || fields[0] != ecs.Panic() {
+		t.Fatalf("expected panic event but got %v", fields)
+	}
+
+	func() {
+		defer func() {
+			if v := recover(); v == nil {
+				t.Fatal("expected re-panic")
+			}
+		}()
+
+		defer log.PanicPolicy{Repanic: true}.Recover(ctx)
+
+		panic(fmt.Errorf("oops"))
+	}()
+}
+
+func TestWithCaller(t *testing.T) {
+	var fields []interface{}
+	logger := log.WithFields(log.LoggerFunc(log.WithCaller(func(f ...interface{}) {
+		fields = f
+	})), ecs.Log("my.logger"))
+
+	logger.Println("hello")
+
+	if len(fields) < 3 || fields[0] != ecs.LogOriginFileName("logger_test.go") {
+		t.Fatalf("expected caller origin but got %v", fields)
+	}
+
+	if fn := fields[2]; fn != ecs.LogOriginFunction("github.com/golangee/log_test.TestWithCaller") {
+		t.Fatalf("unexpected function %v", fn)
+	}
+
+	log.WithCaller(simple.PrintColored)("colored")
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "test.log")
+	t.Setenv(log.EnvFormat, log.FormatECSNested)
+	t.Setenv(log.EnvLevel, "warn")
+	t.Setenv(log.EnvOutput, file)
+	t.Setenv(log.EnvTimeFormat, log.TimeNone)
+
+	cfg, err := log.ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cfg.Apply(); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = log.DefaultConfig().Apply()
+	}()
+
+	log.Println("info", "dropped")
+	log.Println(ecs.Warn(), ecs.Log("my.logger"), ecs.Msg("kept"))
+
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"log":{"level":"warn","logger":"my.logger"},"message":"kept"}` + "\n"
+	if string(buf) != expected {
+		t.Fatalf("expected\n%s\nbut got\n%s", expected, string(buf))
+	}
+
+	t.Setenv(log.EnvLevel, "loud")
+	if _, err := log.ConfigFromEnv(); err == nil {
+		t.Fatal("expected misconfiguration error")
+	}
+}
+
+func TestConfigApplyReplacesRules(t *testing.T) {
+	cfg := log.DefaultConfig()
+	cfg.Rules = []level.Rule{{Prefix: "my.logger", Level: level.Debug}}
+	if err := cfg.Apply(); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		_ = log.DefaultConfig().Apply()
+	}()
+
+	if err := log.DefaultConfig().Apply(); err != nil {
+		t.Fatal(err)
+	}
+
+	if rules := level.Default.Rules(); len(rules) != 0 {
+		t.Fatalf("expected the rules to be replaced but got %v", rules)
+	}
+}
+
+func TestVerbose(t *testing.T) {
+	defer log.SetVerbosity(0)
+	defer func() {
+		_ = log.SetVModule("")
+	}()
+
+	log.SetVerbosity(1)
+	if !log.Verbose(1) || log.Verbose(2) {
+		t.Fatal("unexpected global verbosity")
+	}
+
+	if err := log.SetVModule("logger_test=3,github.com/golangee/log/ecs=5"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if !log.Verbose(3) || log.Verbose(4) {
+			t.Fatal("unexpected file verbosity")
+		}
+	}
+
+	if err := log.SetVModule("other=3"); err != nil {
+		t.Fatal(err)
+	}
+
+	if log.Verbose(3) {
+		t.Fatal("expected cache invalidation")
+	}
+
+	// a single call site without a matching rule must follow the global verbosity
+	for _, v := range []struct{ global, n int }{{5, 6}, {1, 3}} {
+		log.SetVerbosity(v.global)
+		if log.Verbose(v.n) {
+			t.Fatalf("unexpected verbosity %d at global %d", v.n, v.global)
+		}
+	}
+
+	if err := log.SetVModule("x=y"); err == nil {
+		t.Fatal("expected invalid level")
+	}
+}
+
+func TestMergeTagsAndLabels(t *testing.T) {
+	var line string
+	logger := log.WithFields(log.LoggerFunc(func(fields ...interface{}) {
+		line = simple.FormatStructured(fields...)
+	}), ecs.Tags("a", "b"), ecs.Labels(map[string]string{"env": "prod", "team": "x"}))
+
+	logger.Println(ecs.Tags("b", "c"), ecs.Labels(map[string]string{"team": "y", "my.key": "z"}))
+
+	const expected = `{"labels.env":"prod","labels.my_key":"z","labels.team":"y","tags":["a","b","c"]}`
+	if line != expected {
+		t.Fatalf("expected %s but got %s", expected, line)
+	}
+
+	nested := simple.FormatNested(ecs.Labels(map[string]string{"env": "prod"}), ecs.Label("team", "y"))
+	if nested != `{"labels":{"env":"prod","team":"y"}}` {
+		t.Fatalf("unexpected nested labels %s", nested)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bufio"
+	"github.com/golangee/log/ecs"
+	"io"
+	"os"
+	"strings"
+)
+
+// The environment variables, which are expected to be set by the Kubernetes downward API, e.g.
+//
+//	env:
+//	  - name: POD_NAME
+//	    valueFrom:
+//	      fieldRef:
+//	        fieldPath: metadata.name
+const (
+	EnvPodName      = "POD_NAME"      // metadata.name
+	EnvPodNamespace = "POD_NAMESPACE" // metadata.namespace
+	EnvPodUID       = "POD_UID"       // metadata.uid
+)
+
+// envKubernetes is always set by the kubelet.
+const envKubernetes = "KUBERNETES_SERVICE_HOST"
+
+// Kubernetes returns orchestrator.type, orchestrator.namespace, orchestrator.resource.type,
+// orchestrator.resource.name and orchestrator.resource.id of the pod, if the process runs within Kubernetes.
+// The values are taken from the Env* variables, which must be provided by the downward API.
+func Kubernetes() []ecs.Field {
+	if os.Getenv(envKubernetes) == "" {
+		return nil
+	}
+
+	res := []ecs.Field{ecs.OrchestratorType("kubernetes")}
+	add := func(f func(string) ecs.Field, v string) {
+		if v != "" {
+			res = append(res, f(v))
+		}
+	}
+
+	add(ecs.OrchestratorNamespace, os.Getenv(EnvPodNamespace))
+
+	if name := os.Getenv(EnvPodName); name != "" {
+		res = append(res, ecs.OrchestratorResourceType("pod"), ecs.OrchestratorResourceName(name))
+	}
+
+	add(ecs.OrchestratorResourceID, os.Getenv(EnvPodUID))
+
+	return res
+}
+
+// containerID finds the id of the container in a /proc/self/cgroup file, which is the last 64 character
+// hexadecimal segment of a cgroup path, like in docker-<id>.scope, cri-containerd-<id>.scope or
+// /kubepods/burstable/pod<uid>/<id>.
+func containerID(r io.Reader) string {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		i := strings.LastIndexByte(line, ':')
+		if i < 0 {
+			continue
+		}
+
+		segments := strings.Split(line[i+1:], "/")
+		for j := len(segments) - 1; j >= 0; j-- {
+			segment := strings.TrimSuffix(segments[j], ".scope")
+			if k := strings.LastIndexAny(segment, "-:"); k >= 0 {
+				segment = segment[k+1:]
+			}
+
+			if isContainerID(segment) {
+				return segment
+			}
+		}
+	}
+
+	return ""
+}
+
+func isContainerID(s string) bool {
+	if len(s) != 64 {
+		return false
+	}
+
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+
+	return true
+}
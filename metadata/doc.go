@@ -0,0 +1,18 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metadata gathers static ECS fields about the host, the process, the service and its container or
+// Kubernetes pod once, so that they do not need to be added by hand to each logger. Use Fields to prepend them
+// with log.WithFields or Append to append them as a single cached block to each event.
+package metadata
@@ -0,0 +1,276 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"bufio"
+	"github.com/golangee/log/ecs"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//nolint:gochecknoglobals
+var (
+	start      = time.Now() // the package initialization approximates the process start without /proc
+	gatherOnce sync.Once
+	gathered   []ecs.Field
+	procRoot   = "/proc"
+	osRelease  = "/etc/os-release"
+)
+
+// Fields returns the metadata, which is gathered once at the first invocation. The returned slice is shared and
+// must not be modified. It can be passed as a single argument to a logger, e.g.
+//
+//	logger := log.WithFields(log.NewLogger(), metadata.Fields())
+func Fields() []ecs.Field {
+	gatherOnce.Do(func() {
+		gathered = Gather()
+	})
+
+	return gathered
+}
+
+// Append returns a logger function, which appends the cached Fields as a single block to each event.
+func Append(next func(fields ...interface{})) func(fields ...interface{}) {
+	return func(fields ...interface{}) {
+		tmp := make([]interface{}, 0, len(fields)+1)
+		tmp = append(tmp, fields...)
+		tmp = append(tmp, Fields())
+		next(tmp...)
+	}
+}
+
+// Gather collects the metadata without caching:
+//   - host.hostname, host.name, host.architecture, host.os.type and host.os.platform and, if available from
+//     /etc/os-release and /proc, host.os.name, host.os.version, host.os.family and host.os.kernel
+//   - process.pid, process.executable, process.name and process.start, which is taken from /proc or otherwise
+//     approximated by the package initialization
+//   - service.name and service.version from debug.ReadBuildInfo, see also ServiceVersion
+//   - container.id from /proc/self/cgroup and the orchestrator fields of a Kubernetes pod, see Kubernetes
+//
+// Values which cannot be determined are omitted.
+func Gather() []ecs.Field {
+	var res []ecs.Field
+	add := func(f func(string) ecs.Field, v string) {
+		if v != "" {
+			res = append(res, f(v))
+		}
+	}
+
+	hostname, _ := os.Hostname()
+	add(ecs.HostHostname, hostname)
+	add(ecs.HostName, hostname)
+	add(ecs.HostArchitecture, architecture(runtime.GOARCH))
+	add(ecs.HostOSType, osType(runtime.GOOS))
+	add(ecs.HostOSPlatform, runtime.GOOS)
+
+	if release := readOSRelease(osRelease); release != nil {
+		add(ecs.HostOSName, release["NAME"])
+		add(ecs.HostOSVersion, release["VERSION_ID"])
+		add(ecs.HostOSFamily, release["ID"])
+	}
+
+	if kernel, err := os.ReadFile(filepath.Join(procRoot, "sys/kernel/osrelease")); err == nil {
+		add(ecs.HostOSKernel, strings.TrimSpace(string(kernel)))
+	}
+
+	res = append(res, ecs.ProcessPID(int64(os.Getpid())))
+
+	if exe, err := os.Executable(); err == nil {
+		add(ecs.ProcessExecutable, exe)
+		add(ecs.ProcessName, filepath.Base(exe))
+	}
+
+	res = append(res, ecs.ProcessStart(processStart()))
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		add(ecs.ServiceName, serviceName(info))
+		add(ecs.ServiceVersion, ServiceVersion(info))
+	}
+
+	if f, err := os.Open(filepath.Join(procRoot, "self/cgroup")); err == nil {
+		add(ecs.ContainerID, containerID(f))
+		_ = f.Close()
+	}
+
+	res = append(res, Kubernetes()...)
+
+	return res
+}
+
+// clockTicks is USER_HZ, the unit of the start time in /proc/self/stat, which is 100 on all common platforms.
+const clockTicks = 100
+
+// processStart returns the start of the process from /proc and falls back to the package initialization.
+func processStart() time.Time {
+	stat, err := os.ReadFile(filepath.Join(procRoot, "self/stat"))
+	if err != nil {
+		return start
+	}
+
+	f, err := os.Open(filepath.Join(procRoot, "stat"))
+	if err != nil {
+		return start
+	}
+
+	defer f.Close()
+
+	if t, ok := parseProcStart(string(stat), f); ok {
+		return t
+	}
+
+	return start
+}
+
+// parseProcStart adds the starttime of a /proc/[pid]/stat line, which is given in clock ticks since boot, to
+// the btime of /proc/stat, which is the boot time in seconds since the epoch.
+func parseProcStart(stat string, sys io.Reader) (time.Time, bool) {
+	// the command name in parentheses may contain spaces, so the fields are counted after its end
+	end := strings.LastIndexByte(stat, ')')
+	if end < 0 {
+		return time.Time{}, false
+	}
+
+	// the state is the 3rd field and the starttime the 22nd
+	fields := strings.Fields(stat[end+1:])
+	if len(fields) < 20 {
+		return time.Time{}, false
+	}
+
+	ticks, err := strconv.ParseInt(fields[19], 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	scanner := bufio.NewScanner(sys)
+	for scanner.Scan() {
+		if v := strings.TrimPrefix(scanner.Text(), "btime "); v != scanner.Text() {
+			boot, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			if err != nil {
+				return time.Time{}, false
+			}
+
+			return time.Unix(boot, 0).Add(time.Duration(ticks) * time.Second / clockTicks), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// ServiceVersion returns the version of the main module. Development builds, which have no version, are
+// described by their vcs.revision instead, with a -dirty suffix for uncommitted modifications.
+func ServiceVersion(info *debug.BuildInfo) string {
+	if v := info.Main.Version; v != "" && v != "(devel)" {
+		return v
+	}
+
+	var revision, modified string
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			revision = s.Value
+		case "vcs.modified":
+			modified = s.Value
+		}
+	}
+
+	if len(revision) > 12 {
+		revision = revision[:12]
+	}
+
+	if revision != "" && modified == "true" {
+		revision += "-dirty"
+	}
+
+	return revision
+}
+
+// serviceName returns the last segment of the main package path.
+func serviceName(info *debug.BuildInfo) string {
+	if info.Path != "" {
+		return path.Base(info.Path)
+	}
+
+	if info.Main.Path != "" {
+		return path.Base(info.Main.Path)
+	}
+
+	return ""
+}
+
+// architecture maps the go architecture to the usual uname names.
+func architecture(goarch string) string {
+	switch goarch {
+	case "amd64":
+		return "x86_64"
+	case "arm64":
+		return "aarch64"
+	case "386":
+		return "i386"
+	default:
+		return goarch
+	}
+}
+
+// osType maps the go operating system to the expected values of host.os.type.
+func osType(goos string) string {
+	switch goos {
+	case "linux", "windows", "ios", "android":
+		return goos
+	case "darwin":
+		return "macos"
+	case "freebsd", "netbsd", "openbsd", "dragonfly", "solaris", "illumos", "aix":
+		return "unix"
+	default:
+		return ""
+	}
+}
+
+// readOSRelease parses the key=value pairs of an os-release file and returns nil, if it does not exist.
+func readOSRelease(name string) map[string]string {
+	f, err := os.Open(name) //nolint:gosec
+	if err != nil {
+		return nil
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	return parseOSRelease(f)
+}
+
+func parseOSRelease(r io.Reader) map[string]string {
+	res := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if i := strings.IndexByte(line, '='); i > 0 {
+			res[line[:i]] = strings.Trim(line[i+1:], `"'`)
+		}
+	}
+
+	return res
+}
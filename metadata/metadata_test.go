@@ -0,0 +1,90 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metadata
+
+import (
+	"github.com/golangee/log/field"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestContainerID(t *testing.T) {
+	const id = "4e5c1c5d0b1f4a3e9a2a0c6b7e8d9f0a1b2c3d4e5f60718293a4b5c6d7e8f901"
+
+	tests := []string{
+		"12:cpu,cpuacct:/docker/" + id,
+		"0::/system.slice/docker-" + id + ".scope",
+		"11:memory:/kubepods/burstable/pod1234-5678/" + id,
+		"0::/kubepods.slice/kubepods-pod1.slice/cri-containerd-" + id + ".scope",
+	}
+
+	for _, cgroup := range tests {
+		if actual := containerID(strings.NewReader("1:name=systemd:/\n" + cgroup + "\n")); actual != id {
+			t.Errorf("%s: expected container id but got %q", cgroup, actual)
+		}
+	}
+
+	if actual := containerID(strings.NewReader("0::/\n4:memory:/user.slice\n")); actual != "" {
+		t.Fatalf("expected no container but got %q", actual)
+	}
+}
+
+func TestFields(t *testing.T) {
+	os.Setenv(envKubernetes, "10.0.0.1")
+	os.Setenv(EnvPodName, "api-7d9f")
+	defer os.Unsetenv(envKubernetes)
+	defer os.Unsetenv(EnvPodName)
+
+	fields := field.Fields(Gather())
+	values := map[string]interface{}{}
+	for _, f := range fields {
+		values[f.K] = f.V
+	}
+
+	if values["process.pid"] != int64(os.Getpid()) || values["process.start"] == nil {
+		t.Fatalf("expected process fields but got %v", fields)
+	}
+
+	if values["orchestrator.resource.name"] != "api-7d9f" || values["orchestrator.type"] != "kubernetes" {
+		t.Fatalf("expected pod fields but got %v", fields)
+	}
+
+	var events [][]field.DefaultField
+	Append(func(fields ...interface{}) {
+		events = append(events, field.Fields(fields...))
+	})("hello")
+
+	if len(events[0]) != len(Fields())+1 || events[0][0].V != "hello" {
+		t.Fatalf("expected appended block but got %v", events)
+	}
+}
+
+func TestProcessStart(t *testing.T) {
+	const stat = "4242 (my (odd) app) S 1 4242 4242 0 -1 4194560 1 0 0 0 0 0 0 0 20 0 1 0 12345 0 0"
+	actual, ok := parseProcStart(stat, strings.NewReader("cpu  1 2 3\nbtime 1700000000\nprocesses 42\n"))
+	if expected := time.Unix(1700000123, int64(450*time.Millisecond)); !ok || !actual.Equal(expected) {
+		t.Fatalf("expected %v but got %v", expected, actual)
+	}
+
+	if _, ok := parseProcStart("4242 (app) S 1", strings.NewReader("btime 1700000000\n")); ok {
+		t.Fatal("expected a truncated stat to fail")
+	}
+
+	if p := processStart(); p.After(start) || time.Since(p) > 24*time.Hour*365 {
+		t.Fatalf("unexpected process start %v", p)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// proxies is a list of trusted networks.
+type proxies []*net.IPNet
+
+// parseProxies parses the given ips or CIDR notations.
+func parseProxies(specs []string) (proxies, error) {
+	res := make(proxies, 0, len(specs))
+	for _, spec := range specs {
+		spec = strings.TrimSpace(spec)
+		if !strings.Contains(spec, "/") {
+			ip := net.ParseIP(spec)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy ip '%s'", spec)
+			}
+
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+
+			res = append(res, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+
+			continue
+		}
+
+		_, n, err := net.ParseCIDR(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy network: %w", err)
+		}
+
+		res = append(res, n)
+	}
+
+	return res, nil
+}
+
+// contains returns true, if the ip is part of any trusted network.
+func (p proxies) contains(ip net.IP) bool {
+	for _, n := range p {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// clientIP returns the ip of the remote peer. Only if the peer is a trusted proxy, the X-Forwarded-For header
+// is inspected from right to left and the first untrusted address is returned. If the remote address is not an
+// ip, like a unix socket, the result is empty.
+func (p proxies) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return ""
+	}
+
+	if !p.contains(ip) {
+		return host
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+
+		hopIP := net.ParseIP(hop)
+		if hopIP == nil {
+			return host
+		}
+
+		host = hop
+		if !p.contains(hopIP) {
+			return host
+		}
+	}
+
+	return host
+}
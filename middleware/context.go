@@ -0,0 +1,54 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+)
+
+type ctxRequestID struct{}
+
+type ctxTraceParent struct{}
+
+// TraceParentHeader is the W3C trace context header, which is propagated from incoming to outgoing requests.
+const TraceParentHeader = "traceparent"
+
+// WithRequestID creates a new context with the given request id.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxRequestID{}, id)
+}
+
+// RequestID returns the contained request id or the empty string.
+func RequestID(ctx context.Context) string {
+	if id, ok := ctx.Value(ctxRequestID{}).(string); ok {
+		return id
+	}
+
+	return ""
+}
+
+// WithTraceParent creates a new context with the given W3C traceparent value.
+func WithTraceParent(ctx context.Context, traceParent string) context.Context {
+	return context.WithValue(ctx, ctxTraceParent{}, traceParent)
+}
+
+// TraceParent returns the contained W3C traceparent value or the empty string.
+func TraceParent(ctx context.Context) string {
+	if tp, ok := ctx.Value(ctxTraceParent{}).(string); ok {
+		return tp
+	}
+
+	return ""
+}
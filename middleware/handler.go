@@ -0,0 +1,187 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"github.com/golangee/log"
+	"github.com/golangee/log/ecs"
+	"github.com/golangee/log/useragent"
+	"net/http"
+	"time"
+)
+
+// RequestIDHeader is the header which is inspected to find an already assigned request id.
+const RequestIDHeader = "X-Request-Id"
+
+// Options configure the Handler middleware.
+type Options struct {
+	// Logger is the parent logger for all request scoped loggers. If nil, log.NewLogger is used.
+	Logger log.Logger
+	// TrustedProxies contains ips or CIDR networks, whose X-Forwarded-For header is honoured to determine the
+	// client.ip. If empty, the remote address of the connection is always used.
+	TrustedProxies []string
+	// RequestID returns the id of the request. If nil, the RequestIDHeader is used and if that is empty,
+	// a random id is generated.
+	RequestID func(r *http.Request) string
+	// Headers is the allow-list of request and response header names to log, see ecs.HTTPOptions.
+	Headers []string
+	// Redact is applied to the allowed headers. If nil, DefaultRedactor is used.
+	Redact Redactor
+	// UserAgents parses the User-Agent into the user_agent.* fields, e.g. useragent.Default. If nil, only
+	// user_agent.original is logged.
+	UserAgents *useragent.Parser
+	// TLS derives the fields of a TLS connection, which are attached to the request scoped logger, e.g.
+	// ecs.TLSInbound. If nil or if the request has not been received over TLS, no tls.* fields are logged.
+	TLS func(cs *tls.ConnectionState) []ecs.Field
+}
+
+// Handler wraps the given handler and logs one ECS event for each request after the next handler has returned.
+// The event contains the http group derived by ecs.HTTP and the url, client and user agent fields. If next
+// panics, the event is logged as an error and the panic is continued, so that net/http can handle it.
+// Before invoking next, a request scoped logger which carries the "http.request.id" is put into the
+// context, so that it can be retrieved by log.FromContext. The request id and an incoming traceparent header
+// are also kept in the context, see RequestID and TraceParent. Handler panics, if the trusted proxies are invalid.
+func Handler(next http.Handler, opts Options) http.Handler {
+	trusted, err := parseProxies(opts.TrustedProxies)
+	if err != nil {
+		panic(err)
+	}
+
+	parent := opts.Logger
+	if parent == nil {
+		parent = log.NewLogger()
+	}
+
+	requestID := opts.RequestID
+	if requestID == nil {
+		requestID = defaultRequestID
+	}
+
+	redact := opts.Redact
+	if redact == nil {
+		redact = DefaultRedactor
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		id := requestID(r)
+		logger := log.WithFields(parent, ecs.HTTPRequestID(id))
+		if opts.TLS != nil && r.TLS != nil {
+			logger = log.WithFields(logger, opts.TLS(r.TLS))
+		}
+
+		rw := &responseWriter{ResponseWriter: w}
+
+		ctx := log.WithLogger(WithRequestID(r.Context(), id), logger)
+		if tp := r.Header.Get(TraceParentHeader); tp != "" {
+			ctx = WithTraceParent(ctx, tp)
+		}
+
+		body := &bodySniffer{}
+		if r.Body != nil && r.Body != http.NoBody {
+			body.ReadCloser = r.Body
+			r.Body = body
+		}
+
+		// the event is also logged, if next panics, and the panic continues afterwards
+		defer func() {
+			p := recover()
+			status, lvl := rw.Status(), ecs.Info()
+			var err error
+			if p != nil {
+				lvl, err = ecs.Error(), panicError(p)
+				if rw.status == 0 {
+					status = http.StatusInternalServerError
+				}
+			}
+
+			res := ecs.HTTPResponse{StatusCode: status, Header: rw.Header(), BodyBytes: rw.bytes, Sniff: rw.sniff}
+			fields := []interface{}{
+				lvl,
+				ecs.HTTP(r, res, ecs.HTTPOptions{RequestSniff: body.sniff, Headers: opts.Headers, Redact: redact}),
+				ecs.URLPath(r.URL.Path),
+				ecs.EventDuration(time.Since(start)),
+				client(trusted, r),
+				userAgent(opts.UserAgents, r.UserAgent()),
+			}
+
+			if r.URL.RawQuery != "" {
+				fields = append(fields, ecs.URLQuery(r.URL.RawQuery))
+			}
+
+			if err != nil {
+				fields = append(fields, err)
+			}
+
+			logger.Println(fields...)
+
+			if p != nil {
+				panic(p)
+			}
+		}()
+
+		next.ServeHTTP(rw, r.WithContext(ctx))
+	})
+}
+
+// panicError returns the recovered value as error.
+func panicError(p interface{}) error {
+	if err, ok := p.(error); ok {
+		return err
+	}
+
+	return fmt.Errorf("panic: %v", p)
+}
+
+// client returns the client.ip or, if the remote address is not an ip, the raw client.address.
+func client(trusted proxies, r *http.Request) ecs.Field {
+	if ip := trusted.clientIP(r); ip != "" {
+		return ecs.ClientIP(ip)
+	}
+
+	return ecs.ClientAddress(r.RemoteAddr)
+}
+
+// userAgent returns the parsed fields or just the original agent, if no parser is available.
+func userAgent(p *useragent.Parser, ua string) []ecs.Field {
+	if p == nil {
+		return []ecs.Field{ecs.UserAgentOriginal(ua)}
+	}
+
+	return p.Parse(ua).Fields()
+}
+
+// defaultRequestID takes the RequestIDHeader or generates a new random id.
+func defaultRequestID(r *http.Request) string {
+	if id := r.Header.Get(RequestIDHeader); id != "" {
+		return id
+	}
+
+	return newID()
+}
+
+// newID returns 16 random bytes as hex string.
+func newID() string {
+	var buf [16]byte
+	if _, err := rand.Read(buf[:]); err != nil {
+		panic(err) // crypto/rand is never expected to fail
+	}
+
+	return hex.EncodeToString(buf[:])
+}
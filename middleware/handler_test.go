@@ -0,0 +1,179 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"github.com/golangee/log"
+	"github.com/golangee/log/field"
+	"github.com/golangee/log/useragent"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type recorder struct {
+	events [][]field.DefaultField
+}
+
+func (r *recorder) Println(fields ...interface{}) {
+	r.events = append(r.events, field.Fields(fields...))
+}
+
+func (r *recorder) value(event int, key string) interface{} {
+	for _, f := range r.events[event] {
+		if f.K == key {
+			return f.V
+		}
+	}
+
+	return nil
+}
+
+func TestHandler(t *testing.T) {
+	rec := &recorder{}
+	h := Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log.FromContext(r.Context()).Println("inner")
+		w.WriteHeader(http.StatusTeapot)
+		_, _ = w.Write([]byte("hello"))
+	}), Options{Logger: rec, TrustedProxies: []string{"10.0.0.0/8"}, Headers: []string{"authorization", "x-missing"},
+		UserAgents: useragent.Default})
+
+	req := httptest.NewRequest(http.MethodGet, "/a/b?c=d", nil)
+	req.RemoteAddr = "10.1.2.3:1234"
+	req.Header.Set("X-Forwarded-For", "1.2.3.4, 10.0.0.1")
+	req.Header.Set(RequestIDHeader, "abc")
+	req.Header.Set("User-Agent", "curl/8.4.0")
+	req.Header.Set("Authorization", "Bearer secret")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	if len(rec.events) != 2 {
+		t.Fatalf("expected 2 events but got %d", len(rec.events))
+	}
+
+	if v := rec.value(0, "http.request.id"); v != "abc" {
+		t.Fatalf("expected request scoped logger but got %v", v)
+	}
+
+	expected := map[string]interface{}{
+		"http.request.id":           "abc",
+		"http.request.method":       "GET",
+		"url.path":                  "/a/b",
+		"url.query":                 "c=d",
+		"http.response.status_code": http.StatusTeapot,
+		"http.response.body.bytes":  int64(5),
+		"http.response.mime_type":   "text/plain",
+		"http.version":              "1.1",
+		"client.ip":                 "1.2.3.4",
+		"user_agent.original":       "curl/8.4.0",
+		"user_agent.name":           "curl",
+	}
+
+	for k, v := range expected {
+		if actual := rec.value(1, k); actual != v {
+			t.Fatalf("expected %s=%v but got %v", k, v, actual)
+		}
+	}
+
+	if h := rec.value(1, "http.request.headers").(map[string]string); len(h) != 1 || h["Authorization"] != Redacted {
+		t.Fatalf("expected only the redacted allowed header but got %v", h)
+	}
+}
+
+func TestHandlerPanic(t *testing.T) {
+	rec := &recorder{}
+	h := Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}), Options{Logger: rec})
+
+	func() {
+		defer func() {
+			if recover() != "boom" {
+				t.Fatal("expected the panic to continue")
+			}
+		}()
+
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	}()
+
+	if len(rec.events) != 1 || rec.value(0, "log.level") != "error" ||
+		rec.value(0, "http.response.status_code") != http.StatusInternalServerError {
+		t.Fatalf("expected an error event but got %v", rec.events)
+	}
+}
+
+func TestHandlerHijack(t *testing.T) {
+	rec := &recorder{}
+	srv := httptest.NewServer(Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		defer conn.Close()
+
+		_, _ = buf.WriteString("HTTP/1.1 204 No Content\r\nConnection: close\r\n\r\n")
+		_ = buf.Flush()
+	}), Options{Logger: rec}))
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected the hijacked response but got %d", res.StatusCode)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	trusted, err := parseProxies([]string{"127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "192.168.0.1:80"
+	req.Header.Set("X-Forwarded-For", "1.2.3.4")
+
+	if ip := trusted.clientIP(req); ip != "192.168.0.1" {
+		t.Fatalf("untrusted peer must not be able to spoof, got %s", ip)
+	}
+
+	req.RemoteAddr = "127.0.0.1:80"
+	if ip := trusted.clientIP(req); ip != "1.2.3.4" {
+		t.Fatalf("expected forwarded ip but got %s", ip)
+	}
+
+	req.RemoteAddr = "@"
+	if ip := trusted.clientIP(req); ip != "" {
+		t.Fatalf("expected no ip for a unix socket but got %s", ip)
+	}
+
+	rec := &recorder{}
+	Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), Options{Logger: rec}).
+		ServeHTTP(httptest.NewRecorder(), req)
+
+	if rec.value(0, "client.ip") != nil || rec.value(0, "client.address") != "@" {
+		t.Fatalf("expected only the raw client.address but got %v", rec.events[0])
+	}
+
+	if rec.value(0, "url.query") != nil {
+		t.Fatal("expected no empty url.query")
+	}
+}
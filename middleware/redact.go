@@ -0,0 +1,57 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// Redacted is the replacement for sensitive values.
+const Redacted = "[REDACTED]"
+
+// A Redactor inspects a captured key and value and returns the value which is safe to be logged. Keys are either
+// canonical header names or the ECS body content keys, like "http.request.body.content".
+type Redactor func(key, value string) string
+
+//nolint:gochecknoglobals
+var sensitiveHeaders = map[string]bool{ // canonical names of headers, which carry credentials
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Api-Key":           true,
+	"X-Auth-Token":        true,
+}
+
+// DefaultRedactor replaces credential carrying headers, like Authorization or Cookie, with Redacted and keeps
+// anything else.
+func DefaultRedactor(key, value string) string {
+	if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+		return Redacted
+	}
+
+	return value
+}
+
+// headers converts the given header into a redacted flat map. Multiple values are joined by a comma.
+func headers(h http.Header, redact Redactor) map[string]string {
+	res := make(map[string]string, len(h))
+	for k, v := range h {
+		res[k] = redact(k, strings.Join(v, ","))
+	}
+
+	return res
+}
@@ -0,0 +1,187 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"bytes"
+	"github.com/golangee/log"
+	"github.com/golangee/log/ecs"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Transport is a http.RoundTripper which logs one ECS event for each outgoing request. The logger is taken from
+// the requests context using log.FromContext, so the request id of an incoming request is correlated
+// automatically. A request id or traceparent found in the context (see Handler) is propagated, if the
+// request does not already define it.
+type Transport struct {
+	// Next performs the actual round trip. If nil, http.DefaultTransport is used.
+	Next http.RoundTripper
+	// CaptureHeaders enables logging of the request and response headers.
+	CaptureHeaders bool
+	// CaptureBody is the maximum amount of bytes of the request and response bodies to log. Zero disables it.
+	CaptureBody int
+	// Redact is applied to all captured headers and bodies. If nil, DefaultRedactor is used.
+	Redact Redactor
+}
+
+// RoundTrip executes the request using the next RoundTripper and logs the outcome.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	redact := t.Redact
+	if redact == nil {
+		redact = DefaultRedactor
+	}
+
+	orig := req
+	req = t.propagate(req)
+	fields := []interface{}{
+		ecs.HTTPRequestMethod(req.Method),
+		ecs.URLFull(stripCredentials(req.URL)),
+	}
+
+	fields = append(fields, destination(req.URL)...)
+
+	if t.CaptureHeaders {
+		fields = append(fields, ecs.HTTPRequestHeaders(headers(req.Header, redact)))
+	}
+
+	if t.CaptureBody > 0 && req.Body != nil && req.Body != http.NoBody {
+		if req == orig {
+			// the body is replaced, which must not be visible to the caller
+			req = req.Clone(req.Context())
+		}
+
+		var content string
+		content, req.Body = peek(req.Body, t.CaptureBody)
+		fields = append(fields, ecs.HTTPRequestBodyContent(redact("http.request.body.content", content)))
+	}
+
+	start := time.Now()
+	res, err := next.RoundTrip(req)
+	fields = append(fields, ecs.EventDuration(time.Since(start)))
+
+	if err != nil {
+		fields = append(fields, ecs.Error(), err)
+		log.FromContext(req.Context()).Println(fields...)
+
+		return res, err
+	}
+
+	fields = append(fields, ecs.Info(), ecs.HTTPResponseStatusCode(res.StatusCode))
+
+	if t.CaptureHeaders {
+		fields = append(fields, ecs.HTTPResponseHeaders(headers(res.Header, redact)))
+	}
+
+	if t.CaptureBody > 0 && res.Body != nil {
+		var content string
+		content, res.Body = peek(res.Body, t.CaptureBody)
+		fields = append(fields, ecs.HTTPResponseBodyContent(redact("http.response.body.content", content)))
+	}
+
+	log.FromContext(req.Context()).Println(fields...)
+
+	return res, nil
+}
+
+// propagate returns a shallow copy with the request id and the traceparent header from the context, if the
+// request does not already have them. A RoundTripper must not modify the original request.
+func (t *Transport) propagate(req *http.Request) *http.Request {
+	id := RequestID(req.Context())
+	tp := TraceParent(req.Context())
+
+	needsID := id != "" && req.Header.Get(RequestIDHeader) == ""
+	needsTP := tp != "" && req.Header.Get(TraceParentHeader) == ""
+
+	if !needsID && !needsTP {
+		return req
+	}
+
+	req = req.Clone(req.Context())
+	if needsID {
+		req.Header.Set(RequestIDHeader, id)
+	}
+
+	if needsTP {
+		req.Header.Set(TraceParentHeader, tp)
+	}
+
+	return req
+}
+
+// stripCredentials returns the url without any user info.
+func stripCredentials(u *url.URL) string {
+	if u.User == nil {
+		return u.String()
+	}
+
+	tmp := *u
+	tmp.User = nil
+
+	return tmp.String()
+}
+
+// destination returns the ip or domain and the port of the url. Well-known ports are derived from the scheme.
+func destination(u *url.URL) []interface{} {
+	var res []interface{}
+	if ip := net.ParseIP(u.Hostname()); ip != nil {
+		res = append(res, ecs.DestinationIP(ip.String()))
+	} else {
+		res = append(res, ecs.DestinationDomain(u.Hostname()))
+	}
+
+	port := u.Port()
+	if port == "" {
+		switch u.Scheme {
+		case "http":
+			res = append(res, ecs.DestinationPort(80))
+		case "https":
+			res = append(res, ecs.DestinationPort(443))
+		}
+
+		return res
+	}
+
+	if p, err := strconv.Atoi(port); err == nil {
+		res = append(res, ecs.DestinationPort(p))
+	}
+
+	return res
+}
+
+// peek reads up to max bytes and returns them as string together with a replacement reader, which still
+// returns the entire content.
+func peek(body io.ReadCloser, max int) (string, io.ReadCloser) {
+	// a read error is not lost, because the replacement reader will just hit it again
+	buf, _ := ioutil.ReadAll(io.LimitReader(body, int64(max)))
+
+	return string(buf), struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: io.MultiReader(bytes.NewReader(buf), body),
+		Closer: body,
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"github.com/golangee/log"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Set-Cookie", "secret")
+		_, _ = w.Write([]byte(r.Header.Get(RequestIDHeader)))
+	}))
+	defer srv.Close()
+
+	rec := &recorder{}
+	ctx := log.WithLogger(WithRequestID(context.Background(), "abc"), rec)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.Replace(srv.URL, "://", "://user:pwd@", 1), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &http.Client{Transport: &Transport{CaptureHeaders: true, CaptureBody: 2}}
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, _ := ioutil.ReadAll(res.Body)
+	_ = res.Body.Close()
+
+	if string(body) != "abc" {
+		t.Fatalf("expected propagated request id but got %s", string(body))
+	}
+
+	if v := rec.value(0, "url.full"); strings.Contains(v.(string), "pwd") {
+		t.Fatalf("credentials must be stripped: %v", v)
+	}
+
+	if v := rec.value(0, "http.response.body.content"); v != "ab" {
+		t.Fatalf("expected truncated body but got %v", v)
+	}
+
+	if v := rec.value(0, "http.response.headers").(map[string]string)["Set-Cookie"]; v != Redacted {
+		t.Fatalf("expected redacted cookie but got %v", v)
+	}
+}
+
+func TestTransportKeepsRequest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	body := ioutil.NopCloser(strings.NewReader("hello"))
+	req, err := http.NewRequestWithContext(log.WithLogger(context.Background(), &recorder{}), http.MethodPost,
+		srv.URL, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := (&Transport{CaptureBody: 2}).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = res.Body.Close()
+
+	if req.Body != body {
+		t.Fatal("the body of the original request must not be replaced")
+	}
+}
+
+func TestTransportDestination(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	rec := &recorder{}
+	req, err := http.NewRequestWithContext(log.WithLogger(context.Background(), rec), http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := (&Transport{}).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = res.Body.Close()
+
+	if v := rec.value(0, "destination.ip"); v != "127.0.0.1" {
+		t.Fatalf("expected destination.ip but got %v", v)
+	}
+
+	if v := rec.value(0, "destination.domain"); v != nil {
+		t.Fatalf("expected no destination.domain for an ip but got %v", v)
+	}
+}
+
+func TestDestination(t *testing.T) {
+	tests := []struct {
+		url  string
+		key  string
+		host string
+		port int
+	}{
+		{"https://example.com/a", "destination.domain", "example.com", 443},
+		{"http://localhost:8080", "destination.domain", "localhost", 8080},
+		{"http://10.0.0.1", "destination.ip", "10.0.0.1", 80},
+		{"https://[::1]:8443", "destination.ip", "::1", 8443},
+	}
+
+	for _, tt := range tests {
+		u, err := url.Parse(tt.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rec := &recorder{}
+		rec.Println(destination(u)...)
+
+		if v := rec.value(0, tt.key); v != tt.host {
+			t.Fatalf("%s: expected %s=%s but got %v", tt.url, tt.key, tt.host, v)
+		}
+
+		if v := rec.value(0, "destination.port"); v != tt.port {
+			t.Fatalf("%s: expected port %d but got %v", tt.url, tt.port, v)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"bufio"
+	"github.com/golangee/log/ecs"
+	"io"
+	"net"
+	"net/http"
+)
+
+// responseWriter captures the status code, the amount of written body bytes and the first bytes of the body to
+// detect its mime type.
+type responseWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+	sniff  []byte
+}
+
+// WriteHeader remembers the status code and delegates.
+func (w *responseWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write counts the bytes and delegates. If no status has been written yet, it is implicitly 200.
+func (w *responseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += int64(n)
+	w.sniff = appendSniff(w.sniff, b[:n])
+
+	return n, err
+}
+
+// Flush delegates to the wrapped writer, if it supports flushing.
+func (w *responseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		if w.status == 0 {
+			w.status = http.StatusOK
+		}
+
+		f.Flush()
+	}
+}
+
+// Hijack delegates to the wrapped writer, if it supports hijacking, e.g. for websocket upgrades. The status
+// is recorded as 101 Switching Protocols, if nothing has been written before.
+func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, http.ErrNotSupported
+	}
+
+	if w.status == 0 {
+		w.status = http.StatusSwitchingProtocols
+	}
+
+	return h.Hijack()
+}
+
+// Unwrap returns the original writer, so that http.ResponseController can find optional interfaces.
+func (w *responseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Status returns the written status code. If nothing has been written at all, net/http will respond with 200.
+func (w *responseWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+
+	return w.status
+}
+
+// bodySniffer keeps the first bytes read from a request body, to detect its mime type.
+type bodySniffer struct {
+	io.ReadCloser
+	sniff []byte
+}
+
+// Read delegates and remembers the first bytes.
+func (b *bodySniffer) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.sniff = appendSniff(b.sniff, p[:n])
+
+	return n, err
+}
+
+// appendSniff appends as much bytes as required to fill ecs.SniffLen.
+func appendSniff(sniff, p []byte) []byte {
+	if rem := ecs.SniffLen - len(sniff); rem > 0 {
+		if len(p) < rem {
+			rem = len(p)
+		}
+
+		sniff = append(sniff, p[:rem]...)
+	}
+
+	return sniff
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"github.com/golangee/log/ecs"
+	"reflect"
+)
+
+// PanicPolicy decides what happens after a recovered panic has been logged. The zero value just swallows
+// the panic.
+type PanicPolicy struct {
+	// Repanic causes a panic with the original value after logging.
+	Repanic bool
+	// Hook is invoked with the recovered value after logging and before a re-panic, if not nil.
+	Hook func(ctx context.Context, v interface{})
+}
+
+// Recover must be deferred directly and logs a recovered panic at the panic level, including the error message,
+// error type and the stack trace of the panic site. The logger is taken from the context, see FromContext.
+// Afterwards the policy is applied.
+//
+//	defer log.PanicPolicy{Repanic: true}.Recover(ctx)
+func (p PanicPolicy) Recover(ctx context.Context) {
+	if v := recover(); v != nil {
+		p.handle(ctx, v)
+	}
+}
+
+// Go launches fn in a new goroutine and applies the policy to any panic which escapes from fn.
+func (p PanicPolicy) Go(ctx context.Context, fn func(ctx context.Context)) {
+	go func() {
+		defer p.Recover(ctx)
+
+		fn(ctx)
+	}()
+}
+
+func (p PanicPolicy) handle(ctx context.Context, v interface{}) {
+	FromContext(ctx).Println(panicFields(v)...)
+
+	if p.Hook != nil {
+		p.Hook(ctx, v)
+	}
+
+	if p.Repanic {
+		panic(v)
+	}
+}
+
+// Recover must be deferred directly and logs and swallows a recovered panic. See also PanicPolicy.
+func Recover(ctx context.Context) {
+	if v := recover(); v != nil {
+		PanicPolicy{}.handle(ctx, v)
+	}
+}
+
+// Go launches fn in a new goroutine and logs and swallows any panic. See also PanicPolicy.
+func Go(ctx context.Context, fn func(ctx context.Context)) {
+	PanicPolicy{}.Go(ctx, fn)
+}
+
+// panicFields describes the recovered value. Errors are taken as they are, anything else is formatted.
+func panicFields(v interface{}) []interface{} {
+	fields := []interface{}{ecs.Panic(), ecs.Msg("recovered panic")}
+	if err, ok := v.(error); ok {
+		fields = append(fields, ecs.ErrMsg(err), ecs.ErrType(err))
+	} else {
+		fields = append(fields, ecs.Field{K: "error.message", V: fmt.Sprint(v)},
+			ecs.Field{K: "error.type", V: reflect.TypeOf(v).String()})
+	}
+
+	// we are still on the panicking goroutine, so the stack contains the panic site
+	return append(fields, ecs.ErrStack())
+}
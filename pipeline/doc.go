@@ -0,0 +1,40 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pipeline builds the default logger from a declarative json document, which describes a list of
+// processors and sinks. The configuration file can be watched, so that a changed pipeline is swapped atomically
+// at runtime, without losing any in-flight event. A typical setup looks like this:
+//
+//	p, err := pipeline.Watch(ctx, "/etc/myservice/logging.json", 5*time.Second, nil)
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	log.SetDefault(p.Println)
+//
+// An example document:
+//
+//	{
+//	  "processors": [
+//	    {"type": "level", "level": "info,my.service.*=debug"},
+//	    {"type": "redact", "keys": ["user.email"]},
+//	    {"type": "fields", "fields": {"service.name": "myservice"}},
+//	    {"type": "time", "layout": "rfc3339nano"}
+//	  ],
+//	  "sinks": [
+//	    {"format": "console", "output": "stderr", "color": true},
+//	    {"format": "json", "output": "/var/log/myservice/audit.log", "level": "warn", "loggers": ["my.audit"]}
+//	  ]
+//	}
+package pipeline
@@ -0,0 +1,190 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golangee/log/level"
+	"strings"
+)
+
+// The supported processor types.
+const (
+	ProcessorLevel  = "level"  // drops events below Level
+	ProcessorRedact = "redact" // replaces the values of Keys
+	ProcessorFields = "fields" // prepends the static Fields
+	ProcessorTime   = "time"   // prepends the @timestamp using Layout
+)
+
+// Redacted is the replacement of redacted values.
+const Redacted = "[REDACTED]"
+
+// Document is the declarative description of a pipeline. Each event passes all processors in order and is
+// then written to each sink, whose level and routing settings match.
+type Document struct {
+	Processors []Processor `json:"processors,omitempty"`
+	Sinks      []Sink      `json:"sinks"`
+}
+
+// Processor is a single step in the pipeline, which is applied before an event is routed to the sinks.
+type Processor struct {
+	// Type is one of the Processor* constants.
+	Type string `json:"type"`
+	// Level is the minimum level for the level processor, optionally followed by rules, see level.ParseRules.
+	Level string `json:"level,omitempty"`
+	// Keys to be redacted by the redact processor.
+	Keys []string `json:"keys,omitempty"`
+	// Fields to be prepended by the fields processor.
+	Fields map[string]interface{} `json:"fields,omitempty"`
+	// Layout is rfc3339 (default), rfc3339nano or a time.Format layout for the time processor.
+	Layout string `json:"layout,omitempty"`
+}
+
+// Sink formats and writes the events.
+type Sink struct {
+	// Name is optional and used for reporting. Defaults to the output.
+	Name string `json:"name,omitempty"`
+	// Format is one of json, ecs-nested, logfmt, console or text.
+	Format string `json:"format"`
+	// Output is stdout, stderr or a file path to append to.
+	Output string `json:"output"`
+	// Color enables colors for the console format.
+	Color bool `json:"color,omitempty"`
+	// Level is the optional minimum level of events written to this sink.
+	Level string `json:"level,omitempty"`
+	// Loggers optionally restricts the sink to events whose log.logger is one of the given names or a child of it.
+	Loggers []string `json:"loggers,omitempty"`
+}
+
+// ValidationError describes an invalid value in a Document.
+type ValidationError struct {
+	// Path is the location of the invalid value, like sinks[1].format.
+	Path string
+	// Err describes the problem.
+	Err error
+}
+
+// Error returns the path and the problem.
+func (e *ValidationError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the problem.
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors contains all problems of a Document.
+type ValidationErrors []*ValidationError
+
+// Error joins all problems.
+func (e ValidationErrors) Error() string {
+	tmp := make([]string, 0, len(e))
+	for _, err := range e {
+		tmp = append(tmp, err.Error())
+	}
+
+	return strings.Join(tmp, "; ")
+}
+
+// Parse decodes and validates a json document. Unknown fields are rejected, to detect typos.
+func Parse(buf []byte) (Document, error) {
+	var doc Document
+
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(&doc); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return doc, &ValidationError{Path: typeErr.Field, Err: fmt.Errorf("expected %s but got %s",
+				typeErr.Type, typeErr.Value)}
+		}
+
+		return doc, fmt.Errorf("invalid pipeline document: %w", err)
+	}
+
+	return doc, doc.Validate()
+}
+
+// Validate checks the entire document and returns nil or ValidationErrors.
+func (d Document) Validate() error {
+	var errs ValidationErrors
+
+	report := func(path string, err error) {
+		errs = append(errs, &ValidationError{Path: path, Err: err})
+	}
+
+	for i, p := range d.Processors {
+		path := fmt.Sprintf("processors[%d]", i)
+
+		switch p.Type {
+		case ProcessorLevel:
+			if _, err := level.ParseRules(p.Level); err != nil {
+				report(path+".level", err)
+			} else if strings.TrimSpace(p.Level) == "" {
+				report(path+".level", errors.New("level is required"))
+			}
+		case ProcessorRedact:
+			if len(p.Keys) == 0 {
+				report(path+".keys", errors.New("at least one key is required"))
+			}
+		case ProcessorFields:
+			if len(p.Fields) == 0 {
+				report(path+".fields", errors.New("at least one field is required"))
+			}
+		case ProcessorTime:
+		default:
+			report(path+".type", fmt.Errorf("unknown processor type '%s'", p.Type))
+		}
+	}
+
+	if len(d.Sinks) == 0 {
+		report("sinks", errors.New("at least one sink is required"))
+	}
+
+	for i, s := range d.Sinks {
+		path := fmt.Sprintf("sinks[%d]", i)
+
+		if _, ok := formats[s.Format]; !ok {
+			report(path+".format", fmt.Errorf("unknown format '%s'", s.Format))
+		}
+
+		if strings.TrimSpace(s.Output) == "" {
+			report(path+".output", errors.New("output is required"))
+		}
+
+		if s.Level != "" {
+			if _, err := level.Parse(s.Level); err != nil {
+				report(path+".level", err)
+			}
+		}
+
+		for j, name := range s.Loggers {
+			if strings.TrimSpace(name) == "" {
+				report(fmt.Sprintf("%s.loggers[%d]", path, j), errors.New("logger name must not be empty"))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
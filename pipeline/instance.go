@@ -0,0 +1,326 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"fmt"
+	"github.com/golangee/log/ecs"
+	"github.com/golangee/log/field"
+	"github.com/golangee/log/level"
+	"github.com/golangee/log/simple"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+//nolint:gochecknoglobals
+var formats = map[string]func(color bool) simple.Formatter{
+	"json": func(bool) simple.Formatter {
+		return simple.FormatStructured
+	},
+	"ecs-nested": func(bool) simple.Formatter {
+		return simple.FormatNested
+	},
+	"logfmt": func(bool) simple.Formatter {
+		return simple.FormatLogfmt
+	},
+	"console": func(color bool) simple.Formatter {
+		if color {
+			return simple.FormatColored
+		}
+
+		return simple.FormatConsole
+	},
+	"text": func(bool) simple.Formatter {
+		return simple.FormatText
+	},
+}
+
+// processor transforms the fields of an event. Returning nil drops the event.
+type processor func(fields []interface{}) []interface{}
+
+// output is a shared destination of one or multiple sinks.
+type output struct {
+	mutex sync.Mutex
+	w     io.Writer
+	file  *os.File
+}
+
+func (o *output) writeLine(line string) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	_, err := io.WriteString(o.w, line+"\n")
+
+	return err
+}
+
+// sink is the runtime representation of a Sink.
+type sink struct {
+	name    string
+	output  string
+	format  simple.Formatter
+	min     level.Level
+	loggers []string
+	out     *output
+
+	mutex     sync.Mutex
+	written   uint64
+	dropped   uint64
+	lastError string
+	healthy   bool
+}
+
+// write formats and writes the event and updates the statistics.
+func (s *sink) write(fields []interface{}) {
+	err := s.out.writeLine(s.format(fields...))
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err != nil {
+		s.dropped++
+		s.lastError = err.Error()
+		s.healthy = false
+
+		return
+	}
+
+	s.written++
+	s.healthy = true
+}
+
+// stats returns a snapshot of the statistics.
+func (s *sink) stats() SinkStats {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return SinkStats{
+		Name:      s.name,
+		Output:    s.output,
+		Healthy:   s.healthy,
+		Written:   s.written,
+		Dropped:   s.dropped,
+		LastError: s.lastError,
+	}
+}
+
+// accepts applies the level and routing settings.
+func (s *sink) accepts(lvl level.Level, logger string) bool {
+	if lvl < s.min {
+		return false
+	}
+
+	if len(s.loggers) == 0 {
+		return true
+	}
+
+	for _, name := range s.loggers {
+		if logger == name || strings.HasPrefix(logger, name+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// instance is an immutable pipeline built from a Document. Events are only accepted while it is not closed.
+type instance struct {
+	mutex      sync.RWMutex
+	closed     bool
+	processors []processor
+	sinks      []*sink
+	outputs    []*output
+}
+
+// build creates the instance and opens all outputs. Sinks with the same output share the file.
+func build(doc Document) (*instance, error) {
+	if err := doc.Validate(); err != nil {
+		return nil, err
+	}
+
+	inst := &instance{}
+	for _, p := range doc.Processors {
+		inst.processors = append(inst.processors, newProcessor(p))
+	}
+
+	byOutput := map[string]*output{}
+	for i, s := range doc.Sinks {
+		out, ok := byOutput[s.Output]
+		if !ok {
+			var err error
+
+			out, err = open(s.Output)
+			if err != nil {
+				inst.close()
+
+				return nil, &ValidationError{Path: fmt.Sprintf("sinks[%d].output", i), Err: err}
+			}
+
+			byOutput[s.Output] = out
+			inst.outputs = append(inst.outputs, out)
+		}
+
+		min := level.Trace
+		if s.Level != "" {
+			min, _ = level.Parse(s.Level)
+		}
+
+		name := s.Name
+		if name == "" {
+			name = s.Output
+		}
+
+		inst.sinks = append(inst.sinks, &sink{
+			name:    name,
+			output:  s.Output,
+			healthy: true,
+			format:  formats[s.Format](s.Color),
+			min:     min,
+			loggers: s.Loggers,
+			out:     out,
+		})
+	}
+
+	return inst, nil
+}
+
+func open(name string) (*output, error) {
+	switch name {
+	case "stdout":
+		return &output{w: os.Stdout}, nil
+	case "stderr":
+		return &output{w: os.Stderr}, nil
+	default:
+		f, err := os.OpenFile(name, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644) //nolint:gosec
+		if err != nil {
+			return nil, err
+		}
+
+		return &output{w: f, file: f}, nil
+	}
+}
+
+// println processes the event and writes it to all matching sinks. It returns false, if the instance has
+// already been closed and the event has not been processed.
+func (inst *instance) println(fields []interface{}) bool {
+	inst.mutex.RLock()
+	defer inst.mutex.RUnlock()
+
+	if inst.closed {
+		return false
+	}
+
+	if !level.Default.Enabled(level.Name(fields...), level.Find(fields...)) {
+		return true
+	}
+
+	for _, p := range inst.processors {
+		if fields = p(fields); fields == nil {
+			return true
+		}
+	}
+
+	lvl := level.Find(fields...)
+	logger := level.Name(fields...)
+	for _, s := range inst.sinks {
+		if s.accepts(lvl, logger) {
+			s.write(fields)
+		}
+	}
+
+	return true
+}
+
+// close waits for all in-flight events and closes the files afterwards.
+func (inst *instance) close() {
+	inst.mutex.Lock()
+	defer inst.mutex.Unlock()
+
+	inst.closed = true
+	for _, out := range inst.outputs {
+		if out.file != nil {
+			_ = out.file.Close()
+		}
+	}
+}
+
+func newProcessor(p Processor) processor {
+	switch p.Type {
+	case ProcessorLevel:
+		rules, _ := level.ParseRules(p.Level)
+		store := level.NewStore(level.Trace)
+		store.SetRules(rules...)
+
+		return func(fields []interface{}) []interface{} {
+			if !store.Enabled(level.Name(fields...), level.Find(fields...)) {
+				return nil
+			}
+
+			return fields
+		}
+	case ProcessorRedact:
+		keys := map[string]bool{}
+		for _, k := range p.Keys {
+			keys[k] = true
+		}
+
+		return func(fields []interface{}) []interface{} {
+			resolved := field.Fields(fields...)
+			res := make([]interface{}, 0, len(resolved))
+			for _, f := range resolved {
+				if keys[f.K] {
+					f.V = Redacted
+				}
+
+				res = append(res, f)
+			}
+
+			return res
+		}
+	case ProcessorFields:
+		keys := make([]string, 0, len(p.Fields))
+		for k := range p.Fields {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+
+		static := make([]interface{}, 0, len(keys))
+		for _, k := range keys {
+			static = append(static, field.DefaultField{K: k, V: p.Fields[k]})
+		}
+
+		return func(fields []interface{}) []interface{} {
+			return append(append(make([]interface{}, 0, len(static)+len(fields)), static...), fields...)
+		}
+	default: // time
+		layout := time.RFC3339
+		switch strings.ToLower(p.Layout) {
+		case "", "rfc3339":
+		case "rfc3339nano":
+			layout = time.RFC3339Nano
+		default:
+			layout = p.Layout
+		}
+
+		return func(fields []interface{}) []interface{} {
+			return append([]interface{}{ecs.TimeFormat(layout)}, fields...)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"io/ioutil"
+	"sync"
+	"sync/atomic"
+)
+
+// Pipeline is a logger whose processors and sinks can be swapped atomically at runtime. Use its Println
+// method with log.SetDefault or wrap it into a log.LoggerFunc.
+type Pipeline struct {
+	mutex   sync.Mutex // serializes swaps
+	current atomic.Value
+}
+
+// New builds a pipeline from the document.
+func New(doc Document) (*Pipeline, error) {
+	inst, err := build(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Pipeline{}
+	p.current.Store(inst)
+
+	return p, nil
+}
+
+// Load reads, parses and builds a pipeline from the given json file.
+func Load(path string) (*Pipeline, error) {
+	doc, err := ReadFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return New(doc)
+}
+
+// A Decoder parses a document, e.g. Parse for json.
+type Decoder func(buf []byte) (Document, error)
+
+// ReadFile reads and decodes the given file. If decode is nil, Parse is used.
+func ReadFile(path string, decode Decoder) (Document, error) {
+	if decode == nil {
+		decode = Parse
+	}
+
+	buf, err := ioutil.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return Document{}, err
+	}
+
+	return decode(buf)
+}
+
+// Println processes and writes the event, if it passes the threshold of level.Default. If a swap happens
+// concurrently, the event is either handled by the old or the new pipeline, but never lost.
+func (p *Pipeline) Println(fields ...interface{}) {
+	for {
+		if p.current.Load().(*instance).println(fields) {
+			return
+		}
+	}
+}
+
+// Swap builds the new pipeline and replaces the current one. The old pipeline is closed after all in-flight events
+// have been written. If the document is invalid or an output cannot be opened, the current pipeline is kept.
+func (p *Pipeline) Swap(doc Document) error {
+	inst, err := build(doc)
+	if err != nil {
+		return err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	old := p.current.Load().(*instance)
+	p.current.Store(inst)
+	old.close()
+
+	return nil
+}
+
+// SinkStats describes the health of a sink.
+type SinkStats struct {
+	// Name of the sink.
+	Name string `json:"name"`
+	// Output of the sink.
+	Output string `json:"output"`
+	// Healthy is false, if the last write has failed.
+	Healthy bool `json:"healthy"`
+	// Written is the amount of successfully written events.
+	Written uint64 `json:"written"`
+	// Dropped is the amount of events which could not be written.
+	Dropped uint64 `json:"dropped"`
+	// LastError is the last write error.
+	LastError string `json:"lastError,omitempty"`
+}
+
+// Stats returns the statistics of all sinks of the current pipeline. Statistics are reset, when the pipeline is
+// swapped.
+func (p *Pipeline) Stats() []SinkStats {
+	inst := p.current.Load().(*instance)
+	res := make([]SinkStats, 0, len(inst.sinks))
+	for _, s := range inst.sinks {
+		res = append(res, s.stats())
+	}
+
+	return res
+}
+
+// Close closes all files. Any further event is dropped.
+func (p *Pipeline) Close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.current.Load().(*instance).close()
+	p.current.Store(&instance{})
+}
@@ -0,0 +1,164 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"github.com/golangee/log/ecs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	_, err := Parse([]byte(`{"processors":[{"type":"level","level":"loud"}],"sinks":[{"format":"xml","output":""}]}`))
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected validation errors but got %v", err)
+	}
+
+	paths := make([]string, 0, len(errs))
+	for _, e := range errs {
+		paths = append(paths, e.Path)
+	}
+
+	if strings.Join(paths, ",") != "processors[0].level,sinks[0].format,sinks[0].output" {
+		t.Fatalf("unexpected paths %v", paths)
+	}
+
+	if _, err := Parse([]byte(`{"sinks":[{"format":"json","output":"stderr","colour":true}]}`)); err == nil {
+		t.Fatal("expected unknown field error")
+	}
+}
+
+func TestRouting(t *testing.T) {
+	dir := t.TempDir()
+	all := filepath.Join(dir, "all.log")
+	audit := filepath.Join(dir, "audit.log")
+
+	p, err := New(Document{
+		Processors: []Processor{
+			{Type: ProcessorLevel, Level: "info"},
+			{Type: ProcessorRedact, Keys: []string{"user.email"}},
+			{Type: ProcessorFields, Fields: map[string]interface{}{"service.name": "test"}},
+		},
+		Sinks: []Sink{
+			{Format: "logfmt", Output: all},
+			{Format: "json", Output: audit, Level: "warn", Loggers: []string{"my.audit"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p.Println(ecs.Debug(), ecs.Msg("dropped"))
+	p.Println(ecs.Info(), ecs.Log("my.audit.login"), ecs.Msg("not audited"))
+	p.Println(ecs.Warn(), ecs.Log("my.audit.login"), ecs.Field{K: "user.email", V: "a@b.c"})
+	p.Close()
+
+	assertFile(t, all, `service.name=test log.level=info log.logger=my.audit.login message="not audited"`+"\n"+
+		`service.name=test log.level=warn log.logger=my.audit.login user.email=[REDACTED]`+"\n")
+	assertFile(t, audit, `{"log.level":"warn","log.logger":"my.audit.login","service.name":"test",`+
+		`"user.email":"[REDACTED]"}`+"\n")
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	cfg := filepath.Join(dir, "logging.json")
+	first := filepath.Join(dir, "first.log")
+	second := filepath.Join(dir, "second.log")
+
+	write := func(out string) {
+		doc := `{"sinks":[{"format":"text","output":"` + filepath.ToSlash(out) + `"}]}`
+		if err := ioutil.WriteFile(cfg, []byte(doc), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write(first)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p, err := Watch(ctx, cfg, 5*time.Millisecond, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 200; j++ {
+				p.Println("x")
+			}
+		}()
+	}
+
+	write(second)
+	// ensure a different modification time, even on coarse file systems
+	later := time.Now().Add(time.Second)
+	_ = os.Chtimes(cfg, later, later)
+
+	wg.Wait()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for p.current.Load().(*instance).sinks[0].name != second {
+		if time.Now().After(deadline) {
+			t.Fatal("pipeline has not been reloaded")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	p.Close()
+
+	lines := countLines(t, first) + countLines(t, second)
+	// the reload event is additionally written into the second file
+	if lines != 801 {
+		t.Fatalf("expected all 800 events and the reload event but got %d lines", lines)
+	}
+}
+
+func countLines(t *testing.T, name string) int {
+	buf, err := ioutil.ReadFile(name)
+	if err != nil {
+		return 0
+	}
+
+	return strings.Count(string(buf), "\n")
+}
+
+func assertFile(t *testing.T, name, expected string) {
+	t.Helper()
+
+	buf, err := ioutil.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(buf) != expected {
+		t.Fatalf("expected\n%s\nbut got\n%s", expected, string(buf))
+	}
+}
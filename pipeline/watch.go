@@ -0,0 +1,76 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"context"
+	"github.com/golangee/log/ecs"
+	"os"
+	"time"
+)
+
+// Watch loads the pipeline from the given file and starts polling it for modifications in the given interval,
+// until the context is done. A modified file is swapped in atomically. If the modified document is invalid,
+// the current pipeline is kept and an error event is written to it. If decode is nil, Parse is used.
+func Watch(ctx context.Context, path string, interval time.Duration, decode Decoder) (*Pipeline, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := ReadFile(path, decode)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := New(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		lastMod, lastSize := stat.ModTime(), stat.Size()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			stat, err := os.Stat(path)
+			if err != nil || (stat.ModTime().Equal(lastMod) && stat.Size() == lastSize) {
+				continue
+			}
+
+			lastMod, lastSize = stat.ModTime(), stat.Size()
+
+			doc, err := ReadFile(path, decode)
+			if err == nil {
+				err = p.Swap(doc)
+			}
+
+			if err != nil {
+				p.Println(ecs.Error(), ecs.Log("golangee.log.pipeline"), ecs.Msg("cannot reload pipeline "+path), err)
+			} else {
+				p.Println(ecs.Info(), ecs.Log("golangee.log.pipeline"), ecs.Msg("reloaded pipeline "+path))
+			}
+		}
+	}()
+
+	return p, nil
+}
@@ -0,0 +1,8 @@
+module github.com/golangee/log/pipeline/yaml
+
+go 1.19
+
+require (
+	github.com/golangee/log v0.1.0
+	gopkg.in/yaml.v3 v3.0.1
+)
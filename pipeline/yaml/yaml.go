@@ -0,0 +1,38 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/golangee/log/pipeline"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// Parse decodes and validates a yaml document. The yaml is converted into json and then passed to
+// pipeline.Parse, so the same rules apply, e.g. unknown fields are rejected.
+func Parse(buf []byte) (pipeline.Document, error) {
+	var tmp interface{}
+	if err := yamlv3.Unmarshal(buf, &tmp); err != nil {
+		return pipeline.Document{}, fmt.Errorf("invalid pipeline document: %w", err)
+	}
+
+	js, err := json.Marshal(tmp)
+	if err != nil {
+		return pipeline.Document{}, fmt.Errorf("invalid pipeline document: %w", err)
+	}
+
+	return pipeline.Parse(js)
+}
@@ -0,0 +1,49 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package yaml
+
+import (
+	"errors"
+	"github.com/golangee/log/pipeline"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	doc, err := Parse([]byte(`
+processors:
+  - type: fields
+    fields:
+      service.name: test
+      labels:
+        team: a
+sinks:
+  - format: json
+    output: stdout
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.Sinks[0].Format != "json" || doc.Processors[0].Fields["service.name"] != "test" {
+		t.Fatalf("unexpected document %+v", doc)
+	}
+
+	_, err = Parse([]byte("sinks:\n  - format: xml\n    output: stdout\n"))
+
+	var errs pipeline.ValidationErrors
+	if !errors.As(err, &errs) || errs[0].Path != "sinks[0].format" {
+		t.Fatalf("expected validation error but got %v", err)
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privacy
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/golangee/log/ecs"
+	"github.com/golangee/log/field"
+	"net"
+)
+
+// DefaultPseudonymized contains the keys whose values are replaced, if Options.Pseudonymized is nil.
+//
+//nolint:gochecknoglobals
+var DefaultPseudonymized = []string{
+	ecs.KeyUserEmail,
+	ecs.KeyUserName,
+	ecs.KeyUserFullName,
+}
+
+// DefaultTruncated contains the keys whose ip addresses are truncated, if Options.Truncated is nil.
+//
+//nolint:gochecknoglobals
+var DefaultTruncated = []string{
+	ecs.KeyClientIP,
+	ecs.KeyClientAddress,
+	ecs.KeySourceIP,
+	ecs.KeySourceAddress,
+}
+
+// Options configure Pseudonymize.
+type Options struct {
+	// Key is the secret of the HMAC and must not be empty. Keep it stable to correlate the events of a user over
+	// time and rotate it to unlink them.
+	Key []byte
+	// Pseudonymized contains the keys whose values are replaced by their pseudonym.
+	Pseudonymized []string
+	// HashKey receives the pseudonym of the first replaced value, unless the event already contains it.
+	// If empty, user.hash is used.
+	HashKey string
+	// TruncateIPs zeroes the last octet of IPv4 and the last 64 bit of IPv6 addresses.
+	TruncateIPs bool
+	// Truncated contains the keys whose ip addresses are truncated.
+	Truncated []string
+}
+
+// Pseudonymize returns a logger function, which replaces the values of the pseudonymized keys with their HMAC
+// digest, emits the digest also under the hash key and truncates the ip addresses, before the event is passed
+// to next. Values which are neither strings nor string slices are dropped from pseudonymized keys, because
+// they cannot be pseudonymized reliably. Pseudonymize panics, if the key is empty, so that personal data is
+// never logged in plain text by accident.
+func Pseudonymize(next func(fields ...interface{}), opts Options) func(fields ...interface{}) {
+	if len(opts.Key) == 0 {
+		panic("privacy: the pseudonymization key must not be empty")
+	}
+
+	if opts.Pseudonymized == nil {
+		opts.Pseudonymized = DefaultPseudonymized
+	}
+
+	if opts.HashKey == "" {
+		opts.HashKey = ecs.KeyUserHash
+	}
+
+	if opts.Truncated == nil {
+		opts.Truncated = DefaultTruncated
+	}
+
+	pseudonymized := set(opts.Pseudonymized)
+	truncated := set(opts.Truncated)
+	if !opts.TruncateIPs {
+		truncated = nil
+	}
+
+	return func(fields ...interface{}) {
+		resolved := field.Fields(fields...)
+		res := make([]interface{}, 0, len(resolved)+1)
+		hash := ""
+		hashed := false
+		for _, f := range resolved {
+			switch {
+			case pseudonymized[f.K]:
+				v, ok := pseudonymize(opts.Key, f.V)
+				if !ok {
+					continue
+				}
+
+				if s, ok := v.(string); ok && hash == "" {
+					hash = s
+				}
+
+				f.V = v
+			case truncated[f.K]:
+				f.V = truncate(f.V)
+			case f.K == opts.HashKey:
+				hashed = true
+			}
+
+			res = append(res, f)
+		}
+
+		if hash != "" && !hashed {
+			res = append(res, field.DefaultField{K: opts.HashKey, V: hash})
+		}
+
+		next(res...)
+	}
+}
+
+// Pseudonym returns the hex encoded HMAC-SHA256 of the value.
+func Pseudonym(key []byte, value string) string {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(value))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// TruncateIP returns the /24 network of an IPv4 and the /64 network of an IPv6 address.
+func TruncateIP(ip net.IP) net.IP {
+	if v4 := ip.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32))
+	}
+
+	return ip.Mask(net.CIDRMask(64, 128))
+}
+
+// pseudonymize replaces a string or each element of a string slice.
+func pseudonymize(key []byte, v interface{}) (interface{}, bool) {
+	switch t := v.(type) {
+	case string:
+		return Pseudonym(key, t), true
+	case []string:
+		res := make([]string, 0, len(t))
+		for _, s := range t {
+			res = append(res, Pseudonym(key, s))
+		}
+
+		return res, true
+	default:
+		return nil, false
+	}
+}
+
+// truncate accepts an ip or an ip:port as string or a net.IP. Anything else, like a domain name, is returned as is.
+func truncate(v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		if ip := net.ParseIP(t); ip != nil {
+			return TruncateIP(ip).String()
+		}
+
+		if host, port, err := net.SplitHostPort(t); err == nil {
+			if ip := net.ParseIP(host); ip != nil {
+				return net.JoinHostPort(TruncateIP(ip).String(), port)
+			}
+		}
+	case net.IP:
+		return TruncateIP(t)
+	}
+
+	return v
+}
+
+func set(keys []string) map[string]bool {
+	res := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		res[k] = true
+	}
+
+	return res
+}
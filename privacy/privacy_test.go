@@ -0,0 +1,63 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package privacy
+
+import (
+	"github.com/golangee/log/ecs"
+	"github.com/golangee/log/field"
+	"net"
+	"testing"
+)
+
+func TestPseudonymize(t *testing.T) {
+	var event []field.DefaultField
+	logger := Pseudonymize(func(fields ...interface{}) {
+		event = field.Fields(fields...)
+	}, Options{Key: []byte("secret"), TruncateIPs: true})
+
+	logger(ecs.User("42", "", "jane@example.com", ""), ecs.ClientIP("1.2.3.4"), ecs.SourceIP(net.ParseIP("2001:db8::1")),
+		ecs.ClientAddress("1.2.3.4:5678"), ecs.SourceAddress("[2001:db8::1]:80"))
+
+	pseudonym := Pseudonym([]byte("secret"), "jane@example.com")
+	expected := map[string]interface{}{
+		"user.id":        "42",
+		"user.email":     pseudonym,
+		"user.hash":      pseudonym,
+		"client.ip":      "1.2.3.0",
+		"source.ip":      "2001:db8::",
+		"client.address": "1.2.3.0:5678",
+		"source.address": "[2001:db8::]:80",
+	}
+
+	if len(event) != len(expected) {
+		t.Fatalf("expected %d fields but got %v", len(expected), event)
+	}
+
+	for _, f := range event {
+		if expected[f.K] != f.V {
+			t.Fatalf("expected %s=%v but got %v", f.K, expected[f.K], f.V)
+		}
+	}
+}
+
+func TestEmptyKey(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a panic for an empty key")
+		}
+	}()
+
+	Pseudonymize(func(fields ...interface{}) {}, Options{TruncateIPs: true})
+}
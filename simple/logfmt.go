@@ -0,0 +1,52 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simple
+
+import (
+	"fmt"
+	"github.com/golangee/log/field"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// The PrintLogfmt logger prints the fields in exactly the given order as logfmt key=value pairs using
+// log.Print. Values containing spaces, quotes or equal signs are quoted. Labels are flattened to labels.<key>.
+func PrintLogfmt(v ...interface{}) {
+	log.Print(FormatLogfmt(v...))
+}
+
+// FormatLogfmt returns the line printed by PrintLogfmt, without the trailing line break.
+func FormatLogfmt(v ...interface{}) string {
+	fields := field.FlattenLabels(field.Fields(v...))
+	sb := &strings.Builder{}
+	for i, f := range fields {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+
+		sb.WriteString(f.K)
+		sb.WriteByte('=')
+
+		val := fmt.Sprint(f.V)
+		if val == "" || strings.ContainsAny(val, " =\"\t\r\n") {
+			val = strconv.Quote(val)
+		}
+
+		sb.WriteString(val)
+	}
+
+	return sb.String()
+}
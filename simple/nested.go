@@ -0,0 +1,75 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simple
+
+import (
+	"github.com/golangee/log/field"
+	"log"
+	"sort"
+	"strings"
+)
+
+// The PrintNested logger works like PrintStructured, but the dotted ECS keys are expanded into nested json
+// objects, as expected by the ECS json format. So "log.level" becomes {"log":{"level":...}}. If a key is
+// both, a value and a parent of other keys, the value is kept as dotted key in the parent object.
+func PrintNested(v ...interface{}) {
+	log.Print(FormatNested(v...))
+}
+
+// FormatNested returns the line printed by PrintNested, without the trailing line break.
+func FormatNested(v ...interface{}) string {
+	fields := field.Fields(v...)
+
+	return marshal(fields, nest(collect(fields)))
+}
+
+// nest expands the flat dotted keys into a tree.
+func nest(flat map[string]interface{}) map[string]interface{} {
+	// sorting ensures that a value like "a" is always placed before its conflicting children like "a.b"
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	root := make(map[string]interface{})
+	for _, k := range keys {
+		parent := root
+		path := strings.Split(k, ".")
+		for i, name := range path {
+			if i == len(path)-1 {
+				parent[name] = flat[k]
+				break
+			}
+
+			child, isObj := parent[name].(map[string]interface{})
+			if _, exists := parent[name]; exists && !isObj {
+				// a value already occupies the name, so keep the remaining path dotted
+				parent[strings.Join(path[i:], ".")] = flat[k]
+				break
+			}
+
+			if !isObj {
+				child = make(map[string]interface{})
+				parent[name] = child
+			}
+
+			parent = child
+		}
+	}
+
+	return root
+}
@@ -15,17 +15,25 @@
 package simple
 
 import (
+	"fmt"
 	"github.com/golangee/log/field"
 	"log"
+	"strings"
 )
 
 // The Print logger just prints the fields in exactly the given order and converts the values to string using
 // log.Print. You likely want to disable printing timestamps using log.SetFlags(0).
 func Print(v ...interface{}) {
+	log.Print(FormatText(v...))
+}
+
+// FormatText returns the line printed by Print, without the trailing line break.
+func FormatText(v ...interface{}) string {
 	fields := field.Fields(v...)
 	tmp := make([]interface{}, 0, len(fields))
 	for _, f := range fields {
 		tmp = append(tmp, f)
 	}
-	log.Println(tmp...)
+
+	return strings.TrimSuffix(fmt.Sprintln(tmp...), "\n")
 }
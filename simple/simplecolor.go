@@ -26,10 +26,37 @@ const intendTrace = len("2020-11-20T10:54:11+01:00")
 // The PrintColored logger just prints the fields in exactly the given order and converts the values to string using
 // log.Print. You likely want to disable printing timestamps using log.SetFlags(0).
 // The console print is scattered with color commands and probably only nice for your developer
-// machine.
+// machine. The log.origin fields are rendered as a short file.go:42 tag and decomposed urls only by their
+// url.original.
 func PrintColored(v ...interface{}) {
+	log.Print(formatConsole(true, v...))
+}
+
+// FormatColored returns the line printed by PrintColored, without the trailing line break.
+func FormatColored(v ...interface{}) string {
+	return formatConsole(true, v...)
+}
+
+// The PrintConsole logger prints like PrintColored but without any color commands, e.g. if the output is
+// not a terminal.
+func PrintConsole(v ...interface{}) {
+	log.Print(formatConsole(false, v...))
+}
+
+// FormatConsole returns the line printed by PrintConsole, without the trailing line break.
+func FormatConsole(v ...interface{}) string {
+	return formatConsole(false, v...)
+}
+
+func formatConsole(colored bool, v ...interface{}) string {
 	tmp := make([]interface{}, 0, len(v))
-	fields := field.Fields(v...)
+	color := func(c string) {
+		if colored {
+			tmp = append(tmp, c)
+		}
+	}
+
+	fields := urlTag(originTag(field.Fields(v...)))
 	messageColor := ""
 	for i, field := range fields {
 		needsReset := false
@@ -54,11 +81,14 @@ func PrintColored(v ...interface{}) {
 					field.V = strings.ToUpper(str)
 				}
 
-				tmp = append(tmp, messageColor)
+				color(messageColor)
 			}
 		case "@timestamp":
 			needsReset = true
-			tmp = append(tmp, cyan)
+			color(cyan)
+		case originKey:
+			needsReset = true
+			color(gray)
 		case "error.stack_trace":
 			indent := &strings.Builder{}
 			for i := 0; i < intendTrace; i++ {
@@ -66,21 +96,25 @@ func PrintColored(v ...interface{}) {
 			}
 
 			needsReset = true
-			tmp = append(tmp, red)
+			color(red)
 			if str, ok := field.V.(string); ok {
-				field.V = strings.ReplaceAll(str, "\n", "\n"+indent.String()+red)
+				if colored {
+					indent.WriteString(red)
+				}
+
+				field.V = strings.ReplaceAll(str, "\n", "\n"+indent.String())
 			}
 		case "message":
 			if messageColor != "" {
 				needsReset = true
-				tmp = append(tmp, messageColor)
+				color(messageColor)
 			}
 		}
 
 		tmp = append(tmp, fmt.Sprint(field.V))
 
 		if needsReset {
-			tmp = append(tmp, reset)
+			color(reset)
 		}
 
 		if i < len(fields)-1 {
@@ -88,5 +122,64 @@ func PrintColored(v ...interface{}) {
 		}
 	}
 
-	log.Print(tmp...)
+	return fmt.Sprint(tmp...)
+}
+
+// originKey is a pseudo key for the combined origin tag.
+const originKey = "log.origin"
+
+// originTag replaces the log.origin file name and line fields by a single file:line field and removes the
+// function, which is too verbose for a console.
+func originTag(fields []field.DefaultField) []field.DefaultField {
+	var name, line interface{}
+
+	res := make([]field.DefaultField, 0, len(fields))
+	pos := -1
+	for _, f := range fields {
+		switch f.K {
+		case "log.origin.file.name":
+			name = f.V
+			if pos == -1 {
+				pos = len(res)
+				res = append(res, field.DefaultField{K: originKey})
+			}
+		case "log.origin.file.line":
+			line = f.V
+		case "log.origin.function":
+		default:
+			res = append(res, f)
+		}
+	}
+
+	if pos != -1 {
+		res[pos].V = fmt.Sprintf("%v:%v", name, line)
+	}
+
+	return res
+}
+
+// urlTag removes the other url fields, if the event contains a decomposed url, which is sufficiently
+// represented by its url.original on a console.
+func urlTag(fields []field.DefaultField) []field.DefaultField {
+	decomposed := false
+	for _, f := range fields {
+		if f.K == "url.original" {
+			decomposed = true
+
+			break
+		}
+	}
+
+	if !decomposed {
+		return fields
+	}
+
+	res := fields[:0]
+	for _, f := range fields {
+		if f.K == "url.original" || !strings.HasPrefix(f.K, "url.") {
+			res = append(res, f)
+		}
+	}
+
+	return res
 }
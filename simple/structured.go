@@ -24,29 +24,75 @@ import (
 
 // The PrintStructured logger takes the fields, removes duplicates (only the last is kept), and prints
 // a json serialization as a single line using log.Print. The fields are sorted ascending by name. A special
-// treatment is for message fields, which are simply fmt.Sprint'ed.
+// treatment is for message fields, which are simply fmt.Sprint'ed, for tags, which are united, and for labels,
+// which are merged key by key and printed flat as labels.<key>.
 func PrintStructured(v ...interface{}) {
+	log.Print(FormatStructured(v...))
+}
+
+// FormatStructured returns the line printed by PrintStructured, without the trailing line break.
+func FormatStructured(v ...interface{}) string {
 	fields := field.Fields(v...)
+
+	return marshal(fields, collect(fields))
+}
+
+// marshal returns the single line json or a fallback description of the fields, which cannot be marshalled.
+func marshal(fields []field.DefaultField, obj interface{}) string {
+	buf, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Sprint("unable to marshal fields to json:", string(debug.Stack()), fmt.Sprint(fields))
+	}
+
+	return string(buf)
+}
+
+// collect removes duplicates (only the last is kept), concats messages, unites tags and flattens labels.
+func collect(fields []field.DefaultField) map[string]interface{} {
 	tmp := make(map[string]interface{})
-	for _, f := range fields {
-		if f.K == "message" {
+	for _, f := range field.FlattenLabels(fields) {
+		switch f.K {
+		case "message":
 			s, ok := tmp[f.K]
 			if ok {
 				tmp[f.K] = fmt.Sprint(s, f.V)
 			} else {
 				tmp[f.K] = f.V
 			}
-		} else {
+		case "tags":
+			tmp[f.K] = unite(tmp[f.K], f.V)
+		default:
 			tmp[f.K] = f.V
 		}
+	}
+
+	return tmp
+}
 
+// unite appends the tags which are not yet contained. If any of both is not a string slice, the last one is kept.
+func unite(prev, next interface{}) interface{} {
+	a, ok := prev.([]string)
+	b, ok2 := next.([]string)
+	if !ok || !ok2 {
+		return next
 	}
 
-	buf, err := json.Marshal(tmp)
-	if err != nil {
-		log.Print("unable to marshal fields to json:", string(debug.Stack()), fmt.Sprint(fields))
-		return
+	res := append([]string(nil), a...)
+	for _, tag := range b {
+		if !contains(res, tag) {
+			res = append(res, tag)
+		}
+	}
+
+	return res
+}
+
+func contains(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
 	}
 
-	log.Print(string(buf))
+	return false
 }
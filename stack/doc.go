@@ -0,0 +1,18 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stack captures stack traces using runtime.Callers and provides a structured representation and a
+// compact rendering. Unlike parsing the output of debug.Stack it does neither depend on the output format of the
+// runtime nor on the amount of frames between the capture site and the actual caller.
+package stack
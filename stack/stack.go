@@ -0,0 +1,147 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// maxDepth is the maximum amount of captured frames.
+const maxDepth = 64
+
+// modulePath is used to identify the frames of this module.
+const modulePath = "github.com/golangee/log"
+
+// Frame describes a single resolved stack frame.
+type Frame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// String returns the compact form "function (file:line)".
+func (f Frame) String() string {
+	return f.Function + " (" + f.File + ":" + strconv.Itoa(f.Line) + ")"
+}
+
+// Trace is a list of frames, the innermost first.
+type Trace []Frame
+
+// Capture returns the stack trace of the calling goroutine. The argument skip is the number of frames to skip
+// before recording, with 0 identifying the caller of Capture.
+func Capture(skip int) Trace {
+	pcs := make([]uintptr, maxDepth)
+	n := runtime.Callers(skip+2, pcs)
+
+	return FromPCs(pcs[:n])
+}
+
+// FromPCs resolves the given program counters, as returned by runtime.Callers, into frames.
+func FromPCs(pcs []uintptr) Trace {
+	if len(pcs) == 0 {
+		return nil
+	}
+
+	res := make(Trace, 0, len(pcs))
+	frames := runtime.CallersFrames(pcs)
+	for {
+		frame, more := frames.Next()
+		res = append(res, Frame{
+			Function: frame.Function,
+			File:     frame.File,
+			Line:     frame.Line,
+		})
+
+		if !more {
+			break
+		}
+	}
+
+	return res
+}
+
+// Filter returns a new trace without the frames for which drop returns true.
+func (t Trace) Filter(drop func(f Frame) bool) Trace {
+	res := make(Trace, 0, len(t))
+	for _, f := range t {
+		if !drop(f) {
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
+// String returns the compact rendering with one frame per line.
+func (t Trace) String() string {
+	sb := &strings.Builder{}
+	for i, f := range t {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+
+		sb.WriteString(f.String())
+	}
+
+	return sb.String()
+}
+
+// Internal returns true for frames of the go runtime and of this module, which are usually only noise in
+// a logged stack trace. Tests of this module are not considered to be internal.
+func Internal(f Frame) bool {
+	return strings.HasPrefix(f.Function, "runtime.") ||
+		strings.HasPrefix(f.Function, modulePath+".") ||
+		strings.HasPrefix(f.Function, modulePath+"/")
+}
+
+// Of returns the stack trace carried by a single error (without unwrapping), if it has a StackTrace method.
+// Supported are methods returning a Trace, a []Frame or a slice of program counters, like the
+// StackTrace of github.com/pkg/errors.
+func Of(err error) (Trace, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	switch t := err.(type) {
+	case interface{ StackTrace() Trace }:
+		return t.StackTrace(), true
+	case interface{ StackTrace() []Frame }:
+		return t.StackTrace(), true
+	case interface{ StackTrace() []uintptr }:
+		return FromPCs(t.StackTrace()), true
+	}
+
+	// this is the generic variant, e.g. for the named types of github.com/pkg/errors
+	m := reflect.ValueOf(err).MethodByName("StackTrace")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return nil, false
+	}
+
+	out := m.Call(nil)[0]
+	if out.Kind() != reflect.Slice || out.Type().Elem().Kind() != reflect.Uintptr {
+		return nil, false
+	}
+
+	pcs := make([]uintptr, out.Len())
+	for i := range pcs {
+		// the pkg/errors frames are pc+1, like returned by runtime.Callers, so FromPCs resolves them correctly
+		pcs[i] = uintptr(out.Index(i).Uint())
+	}
+
+	return FromPCs(pcs), true
+}
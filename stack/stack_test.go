@@ -0,0 +1,67 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stack
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// pcStack mimics the named types of github.com/pkg/errors.
+type pcStack []pcFrame
+
+type pcFrame uintptr
+
+type stackError struct {
+	pcs pcStack
+}
+
+func (e stackError) Error() string {
+	return "with stack"
+}
+
+func (e stackError) StackTrace() pcStack {
+	return e.pcs
+}
+
+func TestCapture(t *testing.T) {
+	trace := Capture(0)
+	if len(trace) == 0 || !strings.HasSuffix(trace[0].Function, ".TestCapture") {
+		t.Fatalf("expected the caller as first frame but got\n%v", trace)
+	}
+
+	if trace[0].Line == 0 || !strings.HasSuffix(trace[0].File, "stack_test.go") {
+		t.Fatalf("unexpected frame %v", trace[0])
+	}
+
+	if filtered := trace.Filter(Internal); len(filtered) == len(trace) {
+		t.Fatalf("expected runtime frames to be dropped\n%v", trace)
+	}
+}
+
+func TestOf(t *testing.T) {
+	pcs := make([]uintptr, 8)
+	n := runtime.Callers(1, pcs)
+	tmp := make(pcStack, 0, n)
+	for _, pc := range pcs[:n] {
+		tmp = append(tmp, pcFrame(pc))
+	}
+
+	trace, ok := Of(stackError{pcs: tmp})
+	if !ok || !strings.HasSuffix(trace[0].Function, ".TestOf") {
+		t.Fatalf("expected stack from error but got\n%v", trace)
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package useragent
+
+import (
+	"container/list"
+	"github.com/golangee/log/ecs"
+	"sync"
+)
+
+// DefaultCacheSize is the amount of agents cached by the Default parser.
+const DefaultCacheSize = 1024
+
+// MaxCachedLength is the length of the longest agent which is cached. Longer agents are parsed each time, so that
+// arbitrary header values cannot fill the cache with large strings.
+const MaxCachedLength = 256
+
+// Default is the parser used by Parse and Fields.
+var Default = NewParser(DefaultRules(), DefaultCacheSize) //nolint:gochecknoglobals
+
+// UserAgent contains the parsed parts of a User-Agent. Parts which are unknown are empty.
+type UserAgent struct {
+	Original   string
+	Name       string
+	Version    string
+	DeviceName string
+	OSName     string
+	OSVersion  string
+}
+
+// Fields returns user_agent.original, user_agent.name, user_agent.version, user_agent.device.name,
+// user_agent.os.name and user_agent.os.version. Empty parts are omitted.
+func (u UserAgent) Fields() []ecs.Field {
+	res := []ecs.Field{ecs.UserAgentOriginal(u.Original)}
+	add := func(f func(string) ecs.Field, v string) {
+		if v != "" {
+			res = append(res, f(v))
+		}
+	}
+
+	add(ecs.UserAgentName, u.Name)
+	add(ecs.UserAgentVersion, u.Version)
+	add(ecs.UserAgentDeviceName, u.DeviceName)
+	add(ecs.UserAgentOSName, u.OSName)
+	add(ecs.UserAgentOSVersion, u.OSVersion)
+
+	return res
+}
+
+// Parser applies Rules and caches the results. It is safe for concurrent use.
+type Parser struct {
+	mutex   sync.Mutex
+	rules   *Rules
+	size    int
+	entries map[string]*list.Element
+	lru     *list.List // of UserAgent, the most recently used first
+}
+
+// NewParser creates a parser, which caches up to size agents. A size of zero disables the cache.
+func NewParser(rules *Rules, size int) *Parser {
+	return &Parser{rules: rules, size: size, entries: map[string]*list.Element{}, lru: list.New()}
+}
+
+// Parse returns the parsed agent from the cache or by applying the rules. The rules are applied without holding
+// the lock, so that concurrent cache misses do not block each other.
+func (p *Parser) Parse(ua string) UserAgent {
+	p.mutex.Lock()
+	if e, ok := p.entries[ua]; ok {
+		p.lru.MoveToFront(e)
+		p.mutex.Unlock()
+
+		return e.Value.(UserAgent)
+	}
+
+	rules := p.rules
+	p.mutex.Unlock()
+
+	res := UserAgent{Original: ua}
+	res.Name, res.Version, _ = match(rules.Agents, ua)
+	res.OSName, res.OSVersion, _ = match(rules.OS, ua)
+	res.DeviceName, _, _ = match(rules.Devices, ua)
+
+	if p.size > 0 && len(ua) <= MaxCachedLength {
+		p.cache(rules, res)
+	}
+
+	return res
+}
+
+// cache inserts the agent, unless the rules have been replaced meanwhile or another goroutine was faster.
+func (p *Parser) cache(rules *Rules, ua UserAgent) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.rules != rules {
+		return
+	}
+
+	if _, ok := p.entries[ua.Original]; ok {
+		return
+	}
+
+	p.entries[ua.Original] = p.lru.PushFront(ua)
+	if p.lru.Len() > p.size {
+		oldest := p.lru.Back()
+		p.lru.Remove(oldest)
+		delete(p.entries, oldest.Value.(UserAgent).Original)
+	}
+}
+
+// SetRules replaces the rules and clears the cache.
+func (p *Parser) SetRules(rules *Rules) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.rules = rules
+	p.entries = map[string]*list.Element{}
+	p.lru.Init()
+}
+
+// LoadRules reads the rules from the given file, see ReadRules, and replaces the current rules. On error, the
+// current rules are kept.
+func (p *Parser) LoadRules(path string) error {
+	rules, err := ReadRules(path)
+	if err != nil {
+		return err
+	}
+
+	p.SetRules(rules)
+
+	return nil
+}
+
+// Parse parses the agent using the Default parser.
+func Parse(ua string) UserAgent {
+	return Default.Parse(ua)
+}
+
+// Fields parses the agent using the Default parser and returns its fields.
+func Fields(ua string) []ecs.Field {
+	return Default.Parse(ua).Fields()
+}
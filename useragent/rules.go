@@ -0,0 +1,113 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package useragent
+
+import (
+	_ "embed" // for the default rules
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+)
+
+//go:embed rules.json
+var defaultRules []byte //nolint:gochecknoglobals
+
+// A Rule matches a User-Agent by its regular expression. Name and Version are templates, which may refer to the
+// submatches of the expression, like $1, see regexp.Expand.
+type Rule struct {
+	Regex   string `json:"regex"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+
+	re *regexp.Regexp
+}
+
+// Rules contains the lists of rules for the agent, the operating system and the device. Within each list, the
+// first matching rule wins.
+type Rules struct {
+	Agents  []Rule `json:"agents"`
+	OS      []Rule `json:"os"`
+	Devices []Rule `json:"devices"`
+}
+
+// DefaultRules returns the embedded rules, which cover the common browsers, crawlers, http clients, operating
+// systems and mobile devices.
+func DefaultRules() *Rules {
+	rules, err := ParseRules(strings.NewReader(string(defaultRules)))
+	if err != nil {
+		panic(err) // the embedded rules are tested
+	}
+
+	return rules
+}
+
+// ParseRules decodes and compiles rules from the JSON representation of Rules, like
+//
+//	{"agents": [{"regex": "Firefox/(\\d+(?:\\.\\d+)*)", "name": "Firefox", "version": "$1"}], "os": [], "devices": []}
+func ParseRules(r io.Reader) (*Rules, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	rules := &Rules{}
+	if err := dec.Decode(rules); err != nil {
+		return nil, fmt.Errorf("cannot decode user agent rules: %w", err)
+	}
+
+	lists := map[string][]Rule{"agents": rules.Agents, "os": rules.OS, "devices": rules.Devices}
+	for name, list := range lists {
+		for i := range list {
+			re, err := regexp.Compile(list[i].Regex)
+			if err != nil {
+				return nil, fmt.Errorf("%s[%d]: %w", name, i, err)
+			}
+
+			list[i].re = re
+		}
+	}
+
+	return rules, nil
+}
+
+// ReadRules parses the rules from the given file.
+func ReadRules(path string) (*Rules, error) {
+	f, err := os.Open(path) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	return ParseRules(f)
+}
+
+// match returns the expanded name and version of the first matching rule.
+func match(rules []Rule, ua string) (name, version string, ok bool) {
+	for _, r := range rules {
+		m := r.re.FindStringSubmatchIndex(ua)
+		if m == nil {
+			continue
+		}
+
+		name = string(r.re.ExpandString(nil, r.Name, ua, m))
+		version = string(r.re.ExpandString(nil, r.Version, ua, m))
+
+		return strings.TrimSpace(name), strings.TrimSpace(version), true
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,94 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package useragent
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []UserAgent{
+		{
+			Original: "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) " +
+				"Chrome/120.0.0.0 Safari/537.36",
+			Name: "Chrome", Version: "120.0.0.0", OSName: "Windows", OSVersion: "10",
+		},
+		{
+			Original: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_1_2 like Mac OS X) AppleWebKit/605.1.15 (KHTML, " +
+				"like Gecko) Version/17.1 Mobile/15E148 Safari/604.1",
+			Name: "Mobile Safari", Version: "17.1", DeviceName: "iPhone", OSName: "iOS", OSVersion: "17.1",
+		},
+		{
+			Original: "Mozilla/5.0 (Linux; Android 13; SM-S911B) AppleWebKit/537.36 (KHTML, like Gecko) " +
+				"Chrome/119.0.6045.163 Mobile Safari/537.36",
+			Name: "Chrome Mobile", Version: "119.0.6045.163", DeviceName: "SM-S911B", OSName: "Android",
+			OSVersion: "13",
+		},
+		{
+			Original: "Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+			Name:     "Googlebot", Version: "2.1", DeviceName: "Spider",
+		},
+		{Original: "curl/8.4.0", Name: "curl", Version: "8.4.0"},
+		{Original: "something unknown"},
+	}
+
+	p := NewParser(DefaultRules(), 2)
+	for _, expected := range tests {
+		if actual := p.Parse(expected.Original); actual != expected {
+			t.Errorf("expected %+v but got %+v", expected, actual)
+		}
+	}
+
+	if p.lru.Len() != 2 || len(p.entries) != 2 {
+		t.Fatalf("expected the cache to be limited but got %d entries", p.lru.Len())
+	}
+
+	long := "curl/8.4.0 " + strings.Repeat("x", MaxCachedLength)
+	if ua := p.Parse(long); ua.Name != "curl" {
+		t.Fatalf("expected a long agent to be parsed, got %+v", ua)
+	}
+
+	if _, ok := p.entries[long]; ok {
+		t.Fatal("expected a long agent not to be cached")
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.json")
+	rules := `{"agents": [{"regex": "^MyApp/(\\d+)", "name": "MyApp", "version": "$1"}], "os": [], "devices": []}`
+	if err := ioutil.WriteFile(path, []byte(rules), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	p := NewParser(DefaultRules(), 10)
+	if ua := p.Parse("MyApp/3"); ua.Name != "" {
+		t.Fatalf("unexpected match %+v", ua)
+	}
+
+	if err := p.LoadRules(path); err != nil {
+		t.Fatal(err)
+	}
+
+	if ua := p.Parse("MyApp/3"); ua.Name != "MyApp" || ua.Version != "3" {
+		t.Fatalf("expected the loaded rule to match, got %+v", ua)
+	}
+
+	if _, err := ParseRules(strings.NewReader(`{"agents": [{"regex": "("}]}`)); err == nil {
+		t.Fatal("expected an invalid regex")
+	}
+}
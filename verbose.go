@@ -0,0 +1,196 @@
+// Copyright 2020 Torben Schinke
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+//nolint:gochecknoglobals
+var (
+	verbosity  int32
+	vmoduleGen int32        // incremented on each change, 0 means no rules
+	vmodule    atomic.Value // []vmoduleRule
+	vsites     sync.Map     // uintptr => vsite
+)
+
+// vmoduleRule is a single pattern=N entry.
+type vmoduleRule struct {
+	pattern string
+	level   int32
+}
+
+// vsite is a cached call site. Sites without a matching rule follow the global verbosity, which may change
+// independently of the rules.
+type vsite struct {
+	gen     int32
+	level   int32
+	matched bool
+}
+
+// Verbosity is a boolean, which is returned by Verbose. It is also a Logger which does nothing if false, so that
+// the following is equivalent:
+//
+//	if log.Verbose(2) {
+//		logger.Println(...)
+//	}
+//
+//	log.Verbose(2).Println(...)
+//
+// However, the first form avoids the evaluation of the arguments, if disabled.
+type Verbosity bool
+
+// Println delegates to the default logger, if enabled.
+func (v Verbosity) Println(fields ...interface{}) {
+	if v {
+		defaultFunc(fields...)
+	}
+}
+
+// Verbose returns true, if the verbosity for the calling file is at least n. This is a runtime alternative to the
+// compile time Debug guard, inspired by the V function of glog (which is already taken here by the field
+// shortcut). The verbosity is defined globally by SetVerbosity and can be overridden per file or package by
+// SetVModule. If n is within the global verbosity, the call is just an atomic load. Otherwise, if there are
+// vmodule rules, the caller's program counter is resolved once and cached per call site.
+func Verbose(n int) Verbosity {
+	if int32(n) <= atomic.LoadInt32(&verbosity) {
+		return true
+	}
+
+	gen := atomic.LoadInt32(&vmoduleGen)
+	if gen == 0 {
+		return false
+	}
+
+	var pcs [1]uintptr
+	if runtime.Callers(2, pcs[:]) == 0 {
+		return false
+	}
+
+	site, ok := vsites.Load(pcs[0])
+	if !ok || site.(vsite).gen != gen {
+		level, matched := resolveVModule(pcs[0])
+		site = vsite{gen: gen, level: level, matched: matched}
+		vsites.Store(pcs[0], site)
+	}
+
+	// without a matching rule, the global verbosity applies, which has already been checked above
+	return Verbosity(site.(vsite).matched && int32(n) <= site.(vsite).level)
+}
+
+// SetVerbosity sets the global verbosity level, like the -v flag of glog.
+func SetVerbosity(n int) {
+	atomic.StoreInt32(&verbosity, int32(n))
+}
+
+// SetVModule parses and applies a comma separated list of pattern=N rules, like the -vmodule flag of glog, e.g.
+// "server=2,gopher*=3,github.com/my/app/db=1". A pattern is a filepath.Match glob, which is matched against the
+// file name without the .go extension. If the pattern contains a slash, it is also matched against the full file
+// path without the extension and against the package path, to enable rules per package. The first matching rule
+// wins. An empty spec removes all rules.
+func SetVModule(spec string) error {
+	var rules []vmoduleRule
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		i := strings.LastIndexByte(entry, '=')
+		if i <= 0 {
+			return fmt.Errorf("invalid vmodule rule '%s': expected pattern=N", entry)
+		}
+
+		pattern := entry[:i]
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid vmodule pattern '%s': %w", pattern, err)
+		}
+
+		n, err := strconv.Atoi(entry[i+1:])
+		if err != nil {
+			return fmt.Errorf("invalid vmodule level '%s': %w", entry, err)
+		}
+
+		rules = append(rules, vmoduleRule{pattern: pattern, level: int32(n)})
+	}
+
+	vmodule.Store(rules)
+
+	if len(rules) == 0 {
+		atomic.StoreInt32(&vmoduleGen, 0)
+		return nil
+	}
+
+	for {
+		// the generation must never become 0 again, which means disabled
+		gen := atomic.LoadInt32(&vmoduleGen)
+		next := gen + 1
+		if next <= 0 {
+			next = 1
+		}
+
+		if atomic.CompareAndSwapInt32(&vmoduleGen, gen, next) {
+			return nil
+		}
+	}
+}
+
+// resolveVModule returns the level of the first matching vmodule rule or false, if no rule matches.
+func resolveVModule(pc uintptr) (int32, bool) {
+	rules, _ := vmodule.Load().([]vmoduleRule)
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+
+	file := strings.TrimSuffix(frame.File, ".go")
+	base := path.Base(file)
+	pkg := packagePath(frame.Function)
+
+	for _, r := range rules {
+		if ok, _ := filepath.Match(r.pattern, base); ok {
+			return r.level, true
+		}
+
+		if !strings.Contains(r.pattern, "/") {
+			continue
+		}
+
+		if ok, _ := filepath.Match(r.pattern, file); ok {
+			return r.level, true
+		}
+
+		if ok, _ := filepath.Match(r.pattern, pkg); ok {
+			return r.level, true
+		}
+	}
+
+	return 0, false
+}
+
+// packagePath returns the import path of a fully qualified function name, like github.com/my/app/db.(*T).Func.
+func packagePath(fn string) string {
+	slash := strings.LastIndexByte(fn, '/')
+	if dot := strings.IndexByte(fn[slash+1:], '.'); dot >= 0 {
+		return fn[:slash+1+dot]
+	}
+
+	return fn
+}